@@ -0,0 +1,47 @@
+package orderedmap
+
+import (
+	"github.com/shengmingzhu/datastructures/pair"
+	"github.com/shengmingzhu/datastructures/rbtree"
+)
+
+// Any is the untyped ordered map, keys are compared by a rbtree.CmpFunc.
+// Prefer OrderedMap[K, V], which needs no type assertions.
+type Any interface {
+	Get(interface{}) (interface{}, bool) // O(logN)
+	Put(interface{}, interface{})        // O(logN)
+	Delete(interface{})                  // O(logN)
+
+	Keys() []interface{}   // O(N)
+	Values() []interface{} // O(N)
+
+	Min() (interface{}, interface{})    // O(logN)
+	Max() (interface{}, interface{})    // O(logN)
+	PopMin() (interface{}, interface{}) // O(logN). Call Min() and Delete(), and return Min
+	PopMax() (interface{}, interface{}) // O(logN). Call Max() and Delete(), and return Max
+
+	RangeAll() []pair.Pair                            // O(N)
+	RangeAllDesc() []pair.Pair                        // O(N)
+	Range(minKey, maxKey interface{}) []pair.Pair     // O(logN) + O(K), K is the number of keys between minKey and maxKey.
+	RangeDesc(minKey, maxKey interface{}) []pair.Pair // O(logN) + O(K), K is the number of keys between minKey and maxKey.
+	RangeN(num int, key interface{}) []pair.Pair      // O(logN) + O(K)
+	RangeDescN(num int, key interface{}) []pair.Pair  // O(logN) + O(K)
+
+	Len() int      // O(1)
+	IsEmpty() bool // O(1)
+
+	// String is very useful when debugging
+	// Example: fmt.Println(t) will print as follows:
+	/*
+	 *                               [ 6]B
+	 *           [ 3]B                                   [13]R
+	 * [ 2]R               [ 5]R               [ 8]B               [15]B
+	 *                                              [10]R
+	 */
+	// Deprecated: only for debugging, unstable function
+	String() string
+}
+
+func NewAny(cmp rbtree.CmpFunc) Any {
+	return rbtree.New(cmp)
+}
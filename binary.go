@@ -0,0 +1,350 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// binaryVersion is the first byte of the binary format, it changes whenever the format changes.
+//
+// Version 1:
+//
+//	byte     version
+//	uvarint  N, the number of key-values
+//	section  the N keys in ASC
+//	section  the N values in the same order
+//
+// A section of a bool, integer, float, string or []byte type stores the elements one by one:
+// bools as 1 byte, signed integers as zigzag varints, unsigned integers as uvarints,
+// floats as their IEEE 754 bits in little endian, strings and []byte as a uvarint length and the bytes.
+// A section of a type implementing encoding.BinaryMarshaler stores each element as a uvarint length
+// and the bytes of MarshalBinary. A section of any other type is a uvarint length and the gob encoding
+// of the slice of the elements, or only 0 if it is empty. The concrete types of interface values
+// must be registered by gob.Register then.
+const binaryVersion = 1
+
+// MarshalBinary encodes the map in a compact binary format with the key-values in ASC.
+// O(N)
+func (m *OrderedMap[K, V]) MarshalBinary() ([]byte, error) {
+	b := []byte{binaryVersion}
+	b = binary.AppendUvarint(b, uint64(m.Len()))
+	b, err := appendSection(b, m.Keys())
+	if err != nil {
+		return nil, err
+	}
+	return appendSection(b, m.Values())
+}
+
+// UnmarshalBinary replaces the content of the map by the key-values encoded by MarshalBinary.
+// The keys are stored in ASC, so the tree is built directly from them in O(N).
+// If they are not in ASC by the comparator of m, for example it differs from the one of the encoder,
+// they are put one by one in O(NlogN). A zero OrderedMap is ordered by the natural order of K.
+func (m *OrderedMap[K, V]) UnmarshalBinary(data []byte) error {
+	if err := m.initCmp(); err != nil {
+		return err
+	}
+	r := binaryReader{b: data}
+	if version := r.byte(); r.err == nil && version != binaryVersion {
+		return fmt.Errorf("orderedmap: unsupported binary version %d", version)
+	}
+	n := r.uvarint()
+	if r.err == nil && n > uint64(len(r.b)) {
+		r.err = errCorrupt
+	}
+	if r.err != nil {
+		return r.err
+	}
+	keys := make([]K, n)
+	readSection(&r, keys)
+	values := make([]V, n)
+	readSection(&r, values)
+	if r.err == nil && len(r.b) > 0 {
+		r.err = errCorrupt
+	}
+	if r.err != nil {
+		return r.err
+	}
+
+	m.t.root = nil
+	for i := 1; i < len(keys); i++ {
+		if m.t.cmp(keys[i-1], keys[i]) >= 0 {
+			for i := range keys {
+				m.t.put(keys[i], values[i])
+			}
+			return nil
+		}
+	}
+	i := 0
+	m.t.root = m.t.build(len(keys), func() (K, V) {
+		i++
+		return keys[i-1], values[i-1]
+	})
+	return nil
+}
+
+// GobEncode is the same as MarshalBinary.
+func (m *OrderedMap[K, V]) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode is the same as UnmarshalBinary.
+func (m *OrderedMap[K, V]) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the map under the read lock, see OrderedMap.MarshalBinary.
+func (m *SyncOrderedMap[K, V]) MarshalBinary() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.MarshalBinary()
+}
+
+// UnmarshalBinary decodes the map under the write lock, see OrderedMap.UnmarshalBinary.
+func (m *SyncOrderedMap[K, V]) UnmarshalBinary(data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.UnmarshalBinary(data)
+}
+
+// GobEncode is the same as MarshalBinary.
+func (m *SyncOrderedMap[K, V]) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode is the same as UnmarshalBinary.
+func (m *SyncOrderedMap[K, V]) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// MarshalBinary encodes the snapshot, see OrderedMap.MarshalBinary.
+func (s *Snapshot[K, V]) MarshalBinary() ([]byte, error) {
+	return s.m.MarshalBinary()
+}
+
+// GobEncode is the same as MarshalBinary.
+func (s *Snapshot[K, V]) GobEncode() ([]byte, error) {
+	return s.m.MarshalBinary()
+}
+
+var errCorrupt = errors.New("orderedmap: corrupt binary data")
+
+type sectionKind int
+
+const (
+	sectionNative sectionKind = iota
+	sectionBinaryMarshaler
+	sectionGob
+)
+
+var (
+	binaryMarshalerType   = reflect.TypeFor[encoding.BinaryMarshaler]()
+	binaryUnmarshalerType = reflect.TypeFor[encoding.BinaryUnmarshaler]()
+)
+
+func sectionKindOf(t reflect.Type) sectionKind {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if !t.Implements(binaryMarshalerType) {
+			return sectionNative
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && !t.Implements(binaryMarshalerType) {
+			return sectionNative
+		}
+	}
+	if t.Kind() != reflect.Interface && t.Implements(binaryMarshalerType) && reflect.PointerTo(t).Implements(binaryUnmarshalerType) {
+		return sectionBinaryMarshaler
+	}
+	return sectionGob
+}
+
+func appendSection[T any](b []byte, s []T) ([]byte, error) {
+	switch sectionKindOf(reflect.TypeFor[T]()) {
+	case sectionNative:
+		for i := range s {
+			b = appendNative(b, reflect.ValueOf(&s[i]).Elem())
+		}
+	case sectionBinaryMarshaler:
+		for i := range s {
+			data, err := any(s[i]).(encoding.BinaryMarshaler).MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			b = binary.AppendUvarint(b, uint64(len(data)))
+			b = append(b, data...)
+		}
+	case sectionGob:
+		if len(s) == 0 {
+			return binary.AppendUvarint(b, 0), nil
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+			return nil, err
+		}
+		b = binary.AppendUvarint(b, uint64(buf.Len()))
+		b = append(b, buf.Bytes()...)
+	}
+	return b, nil
+}
+
+func appendNative(b []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, 1)
+		}
+		return append(b, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(b, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(b, v.Uint())
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(v.Float()))
+	case reflect.String:
+		b = binary.AppendUvarint(b, uint64(v.Len()))
+		return append(b, v.String()...)
+	default: // []byte
+		b = binary.AppendUvarint(b, uint64(v.Len()))
+		return append(b, v.Bytes()...)
+	}
+}
+
+// binaryReader reads the binary format, the first error is kept in err and the later reads return zero values.
+type binaryReader struct {
+	b   []byte
+	err error
+}
+
+func (r *binaryReader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.b) == 0 {
+		r.err = errCorrupt
+		return 0
+	}
+	c := r.b[0]
+	r.b = r.b[1:]
+	return c
+}
+
+func (r *binaryReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = errCorrupt
+		return 0
+	}
+	r.b = r.b[n:]
+	return x
+}
+
+func (r *binaryReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n := binary.Varint(r.b)
+	if n <= 0 {
+		r.err = errCorrupt
+		return 0
+	}
+	r.b = r.b[n:]
+	return x
+}
+
+// bytes returns the next n bytes, they are not copied.
+func (r *binaryReader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.b)) {
+		r.err = errCorrupt
+		return nil
+	}
+	b := r.b[:n]
+	r.b = r.b[n:]
+	return b
+}
+
+func readSection[T any](r *binaryReader, s []T) {
+	switch sectionKindOf(reflect.TypeFor[T]()) {
+	case sectionNative:
+		for i := range s {
+			readNative(r, reflect.ValueOf(&s[i]).Elem())
+		}
+	case sectionBinaryMarshaler:
+		for i := range s {
+			data := r.bytes(r.uvarint())
+			if r.err != nil {
+				return
+			}
+			if err := any(&s[i]).(encoding.BinaryUnmarshaler).UnmarshalBinary(data); err != nil {
+				r.err = err
+				return
+			}
+		}
+	case sectionGob:
+		data := r.bytes(r.uvarint())
+		if r.err != nil || len(data) == 0 && len(s) == 0 {
+			return
+		}
+		var decoded []T
+		if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&decoded); err != nil {
+			r.err = err
+			return
+		}
+		if len(decoded) != len(s) {
+			r.err = errCorrupt
+			return
+		}
+		copy(s, decoded)
+	}
+}
+
+func readNative(r *binaryReader, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.byte() != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x := r.varint()
+		if v.OverflowInt(x) {
+			r.err = errCorrupt
+		}
+		v.SetInt(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x := r.uvarint()
+		if v.OverflowUint(x) {
+			r.err = errCorrupt
+		}
+		v.SetUint(x)
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(r.fixed(4)))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(r.fixed(8))))
+	case reflect.String:
+		v.SetString(string(r.bytes(r.uvarint())))
+	default: // []byte
+		v.SetBytes(bytes.Clone(r.bytes(r.uvarint())))
+	}
+}
+
+// fixed returns the next n bytes, or n zero bytes on error.
+func (r *binaryReader) fixed(n int) []byte {
+	if b := r.bytes(uint64(n)); r.err == nil {
+		return b
+	}
+	return make([]byte, n)
+}
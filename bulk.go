@@ -0,0 +1,135 @@
+package orderedmap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// FromSortedSlice returns a map of the key-values ordered by the natural order of K.
+// The keys must be in ASC without duplicates, otherwise an error is returned.
+// The balanced tree is built directly, for example:
+//
+//	m, err := orderedmap.FromSortedSlice([]orderedmap.IntKeyValue{{Key: 1, Value: "a"}, {Key: 2, Value: "b"}})
+//
+// O(N)
+func FromSortedSlice[K cmp.Ordered, V any](pairs []KeyValue[K, V]) (*OrderedMap[K, V], error) {
+	return FromSortedSliceFunc(cmp.Compare[K], pairs)
+}
+
+// FromSortedSliceFunc is the same as FromSortedSlice, but the map is ordered by cmp.
+// O(N)
+func FromSortedSliceFunc[K, V any](cmp func(a, b K) int, pairs []KeyValue[K, V]) (*OrderedMap[K, V], error) {
+	m := NewFunc[K, V](cmp)
+	for i := 1; i < len(pairs); i++ {
+		if err := m.t.checkAsc(pairs[i-1].Key, pairs[i].Key, i); err != nil {
+			return nil, err
+		}
+	}
+	m.t.root = m.t.buildSlice(pairs)
+	return m, nil
+}
+
+// FromSortedSeq returns a map of the key-values yielded by seq ordered by the natural order of K.
+// The keys must be in ASC without duplicates, otherwise an error is returned.
+// O(N)
+func FromSortedSeq[K cmp.Ordered, V any](seq iter.Seq2[K, V]) (*OrderedMap[K, V], error) {
+	return FromSortedSeqFunc(cmp.Compare[K], seq)
+}
+
+// FromSortedSeqFunc is the same as FromSortedSeq, but the map is ordered by cmp.
+// O(N)
+func FromSortedSeqFunc[K, V any](cmp func(a, b K) int, seq iter.Seq2[K, V]) (*OrderedMap[K, V], error) {
+	m := NewFunc[K, V](cmp)
+	var pairs []KeyValue[K, V]
+	for k, v := range seq {
+		if len(pairs) > 0 {
+			if err := m.t.checkAsc(pairs[len(pairs)-1].Key, k, len(pairs)); err != nil {
+				return nil, err
+			}
+		}
+		pairs = append(pairs, KeyValue[K, V]{Key: k, Value: v})
+	}
+	m.t.root = m.t.buildSlice(pairs)
+	return m, nil
+}
+
+// BulkPut puts the key-values into the map, the same as calling Put for each of them in order,
+// so the last value of a duplicated key wins. pairs need not be sorted, and it is not modified.
+// If pairs is large compared to the map, the map is rebuilt from the merge of both in O(N+M),
+// otherwise the key-values are put one by one.
+// O(MlogM + min(MlogN, N+M)), M is len(pairs).
+func (m *OrderedMap[K, V]) BulkPut(pairs []KeyValue[K, V]) {
+	if len(pairs) == 0 {
+		return
+	}
+	sorted := slices.Clone(pairs)
+	slices.SortStableFunc(sorted, func(a, b KeyValue[K, V]) int {
+		return m.t.cmp(a.Key, b.Key)
+	})
+	j := 0
+	for i := range sorted {
+		if j > 0 && m.t.cmp(sorted[j-1].Key, sorted[i].Key) == 0 {
+			sorted[j-1] = sorted[i]
+		} else {
+			sorted[j] = sorted[i]
+			j++
+		}
+	}
+	sorted = sorted[:j]
+
+	n := m.t.len()
+	if fewer(len(sorted), n) {
+		for _, kv := range sorted {
+			m.t.put(kv.Key, kv.Value)
+		}
+		return
+	}
+	merged := make([]KeyValue[K, V], 0, n+len(sorted))
+	var it iterator[K, V]
+	m.t.first(&it)
+	x := it.next()
+	for _, kv := range sorted {
+		for ; x != nil && m.t.cmp(x.key, kv.Key) < 0; x = it.next() {
+			merged = append(merged, KeyValue[K, V]{Key: x.key, Value: x.value})
+		}
+		if x != nil && m.t.cmp(x.key, kv.Key) == 0 {
+			// The same as put, the stored key is kept and only the value is replaced.
+			kv.Key = x.key
+			x = it.next()
+		} else if m.t.cloneKey != nil {
+			kv.Key = m.t.cloneKey(kv.Key)
+		}
+		merged = append(merged, kv)
+	}
+	for ; x != nil; x = it.next() {
+		merged = append(merged, KeyValue[K, V]{Key: x.key, Value: x.value})
+	}
+	m.t.root = m.t.buildSlice(merged)
+}
+
+// BulkPut puts the key-values under the write lock, see OrderedMap.BulkPut.
+func (m *SyncOrderedMap[K, V]) BulkPut(pairs []KeyValue[K, V]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.BulkPut(pairs)
+}
+
+// checkAsc returns an error if prev is not less than key, i is the index of key in the input.
+func (t *tree[K, V]) checkAsc(prev, key K, i int) error {
+	if c := t.cmp(prev, key); c == 0 {
+		return fmt.Errorf("orderedmap: duplicate key %v at index %d", key, i)
+	} else if c > 0 {
+		return fmt.Errorf("orderedmap: key %v at index %d is not in ASC", key, i)
+	}
+	return nil
+}
+
+func (t *tree[K, V]) buildSlice(pairs []KeyValue[K, V]) *node[K, V] {
+	i := 0
+	return t.build(len(pairs), func() (K, V) {
+		i++
+		return pairs[i-1].Key, pairs[i-1].Value
+	})
+}
@@ -0,0 +1,51 @@
+package orderedmap
+
+import (
+	"bytes"
+	"iter"
+)
+
+// Bytes is a map keyed by []byte in the order of bytes.Compare.
+// Put copies a key once when it is inserted, so the caller may reuse its buffer.
+// Get and the other lookups compare the given key in place without allocation.
+// The keys returned by the map are the stored copies, they must not be modified.
+type Bytes = OrderedMap[[]byte, interface{}]
+
+type BytesKeyValue = KeyValue[[]byte, interface{}]
+
+type SyncBytes = SyncOrderedMap[[]byte, interface{}]
+
+func NewBytes() *Bytes {
+	return NewBytesFunc(bytes.Compare)
+}
+
+// NewBytesDesc returns an empty Bytes map in DESC.
+func NewBytesDesc() *Bytes {
+	return NewBytesFunc(Reverse(bytes.Compare))
+}
+
+// NewBytesFunc returns an empty Bytes map ordered by cmp, the keys are copied on insert as well.
+func NewBytesFunc(cmp func(a, b []byte) int) *Bytes {
+	m := NewFunc[[]byte, interface{}](cmp)
+	m.t.cloneKey = bytes.Clone
+	return m
+}
+
+func NewSyncBytes() *SyncBytes {
+	m := NewSyncFunc[[]byte, interface{}](bytes.Compare)
+	m.m.t.cloneKey = bytes.Clone
+	return m
+}
+
+// PrefixScan returns an iterator over the key-values whose keys start with prefix in the order of m.
+// The head of a key is compared with prefix by the comparator of m, so it also works in DESC or a custom order,
+// as long as the keys with a prefix are contiguous in it, such as bytes.Compare and its reverse.
+// O(logN) to start, O(1) amortized per key-value
+func PrefixScan[V any](m *OrderedMap[[]byte, V], prefix []byte) iter.Seq2[[]byte, V] {
+	return m.BetweenFunc(func(key []byte) int {
+		if len(key) >= len(prefix) && m.t.cmp(key[:len(prefix)], prefix) == 0 {
+			return 0
+		}
+		return m.t.cmp(key, prefix)
+	})
+}
@@ -0,0 +1,194 @@
+package orderedmap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Collator orders the keys of a String map. Compare must be a total order, it returns
+// -1 if a < b, 0 if a and b are the same key, and +1 if a > b.
+// For example, a collator of golang.org/x/text/collate can be used by CollatorFunc(c.CompareString).
+type Collator interface {
+	Compare(a, b string) int
+}
+
+// CollatorFunc is a function used as a Collator.
+type CollatorFunc func(a, b string) int
+
+func (f CollatorFunc) Compare(a, b string) int {
+	return f(a, b)
+}
+
+// The built-in collations. Keys equal by a collation are the same key, the one put first is kept.
+// The prefix queries such as PrefixRange follow the collation. In Natural, the keys with a prefix containing
+// digits are not contiguous, "file2" is between "file1" and "file10", so a map of NewStringCollator(Natural)
+// scans the keys sharing the head of the prefix before its first digit instead.
+var (
+	// ASCIIFold ignores the case of the ASCII letters, "apple" < "Banana" < "cherry", the other bytes are compared as they are.
+	ASCIIFold Collator = CollatorFunc(compareASCIIFold)
+	// UnicodeFold ignores the case by the Unicode simple folding, the same as strings.EqualFold.
+	UnicodeFold Collator = CollatorFunc(compareUnicodeFold)
+	// Natural compares the runs of ASCII digits by their numbers, "file2" < "file10".
+	// The numbers equal but for the leading zeros are ordered by the fewer zeros first, "a1" < "a01" < "a2".
+	Natural Collator = natural{}
+)
+
+// NewStringCollator returns an empty String map ordered by c, which is used by Get, Range and the prefix queries.
+func NewStringCollator(c Collator) *String {
+	m := NewFunc[string, interface{}](c.Compare)
+	m.t.prefixHead = prefixHeadOf(c)
+	return m
+}
+
+// NewSyncStringCollator returns an empty SyncString map ordered by c.
+func NewSyncStringCollator(c Collator) *SyncString {
+	m := NewSyncFunc[string, interface{}](c.Compare)
+	m.m.t.prefixHead = prefixHeadOf(c)
+	return m
+}
+
+// prefixHeadOf returns how to find the keys with a prefix in the order of c, nil if they are contiguous.
+func prefixHeadOf(c Collator) func(prefix string) string {
+	if h, ok := c.(interface{ prefixHead(prefix string) string }); ok {
+		return h.prefixHead
+	}
+	return nil
+}
+
+type natural struct{}
+
+func (natural) Compare(a, b string) int {
+	return compareNatural(a, b)
+}
+
+// prefixHead returns the head of prefix before its first digit. The keys with a head without digits are contiguous
+// in Natural, as they are compared byte by byte up to the end of the head.
+func (natural) prefixHead(prefix string) string {
+	for i := 0; i < len(prefix); i++ {
+		if isDigit(prefix[i]) {
+			return prefix[:i]
+		}
+	}
+	return prefix
+}
+
+func compareASCIIFold(a, b string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if ca, cb := lowerASCII(a[i]), lowerASCII(b[i]); ca != cb {
+			if ca < cb {
+				return -1
+			}
+			return 1
+		}
+	}
+	return compareLen(len(a), len(b))
+}
+
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+func compareUnicodeFold(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		ra, wa := foldRune(a[i:])
+		rb, wb := foldRune(b[j:])
+		if ra != rb {
+			if ra < rb {
+				return -1
+			}
+			return 1
+		}
+		i += wa
+		j += wb
+	}
+	return compareLen(len(a)-i, len(b)-j)
+}
+
+// foldRune decodes the first rune of s and returns the least rune of its simple folding orbit,
+// the ASCII letters in lower case. An invalid byte is ordered after all runes by its value.
+func foldRune(s string) (rune, int) {
+	if c := s[0]; c < utf8.RuneSelf {
+		return rune(lowerASCII(c)), 1
+	}
+	r, w := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError && w == 1 {
+		return utf8.MaxRune + 1 + rune(s[0]), 1
+	}
+	m := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		m = min(m, f)
+	}
+	if m < utf8.RuneSelf {
+		m = rune(lowerASCII(byte(m)))
+	}
+	return m, w
+}
+
+func compareNatural(a, b string) int {
+	zeros := 0 // the first difference of the leading zeros, only used if a and b are equal otherwise
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if !isDigit(a[i]) || !isDigit(b[j]) {
+			if a[i] != b[j] {
+				if a[i] < b[j] {
+					return -1
+				}
+				return 1
+			}
+			i++
+			j++
+			continue
+		}
+		za, ea := digits(a, i)
+		zb, eb := digits(b, j)
+		// The numbers without the leading zeros are compared by length and then digit by digit.
+		if c := compareLen(ea-za, eb-zb); c != 0 {
+			return c
+		}
+		for k := 0; k < ea-za; k++ {
+			if a[za+k] != b[zb+k] {
+				if a[za+k] < b[zb+k] {
+					return -1
+				}
+				return 1
+			}
+		}
+		if zeros == 0 {
+			zeros = compareLen(za-i, zb-j)
+		}
+		i, j = ea, eb
+	}
+	if c := compareLen(len(a)-i, len(b)-j); c != 0 {
+		return c
+	}
+	return zeros
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digits returns the start of the number after the leading zeros and the end of the run of digits at s[i].
+func digits(s string, i int) (start, end int) {
+	for i < len(s)-1 && s[i] == '0' && isDigit(s[i+1]) {
+		i++
+	}
+	start = i
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return start, i
+}
+
+func compareLen(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,81 @@
+package orderedmap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrComparator is returned by CheckComparator if the comparator is not a total order on the samples.
+var ErrComparator = errors.New("orderedmap: invalid comparator")
+
+// CheckComparator tests that cmp is a total order on the samples before it is used by NewFunc or NewAny:
+// reflexive, cmp(a, a) == 0; antisymmetric, cmp(a, b) and cmp(b, a) have opposite signs;
+// and transitive, for both < and ==. A comparator breaking them corrupts the order of the map silently.
+// The returned error wraps ErrComparator and shows the samples breaking the order.
+// For example: err := orderedmap.CheckComparator(rbtree.CmpFunc(myCmp), samples)
+// O(N^2) comparisons, plus O(N^3) to find the samples if it is not transitive.
+func CheckComparator[K any](cmp func(a, b K) int, samples []K) error {
+	for _, a := range samples {
+		if c := cmp(a, a); c != 0 {
+			return fmt.Errorf("%w: not reflexive, cmp(%v, %v) = %d", ErrComparator, a, a, c)
+		}
+	}
+	for i, a := range samples {
+		for _, b := range samples[i+1:] {
+			if ab, ba := cmp(a, b), cmp(b, a); sign(ab) != -sign(ba) {
+				return fmt.Errorf("%w: not antisymmetric, cmp(%v, %v) = %d, cmp(%v, %v) = %d", ErrComparator, a, b, ab, b, a, ba)
+			}
+		}
+	}
+	// A total order puts the equal samples into contiguous blocks once sorted,
+	// and every pair compares the same as its blocks.
+	s := slices.Clone(samples)
+	slices.SortFunc(s, cmp)
+	block := make([]int, len(s))
+	for i := 1; i < len(s); i++ {
+		block[i] = block[i-1]
+		if cmp(s[i-1], s[i]) != 0 {
+			block[i]++
+		}
+	}
+	for i := range s {
+		for j := i + 1; j < len(s); j++ {
+			if sign(cmp(s[i], s[j])) != sign(block[i]-block[j]) {
+				return transitivityError(cmp, s)
+			}
+		}
+	}
+	return nil
+}
+
+// transitivityError finds three samples breaking the transitivity.
+func transitivityError[K any](cmp func(a, b K) int, s []K) error {
+	for _, a := range s {
+		for _, b := range s {
+			ab := sign(cmp(a, b))
+			if ab > 0 {
+				continue
+			}
+			for _, c := range s {
+				// a <= b <= c must give a <= c, and a == c if a == b == c.
+				if bc := sign(cmp(b, c)); bc <= 0 {
+					if ac := sign(cmp(a, c)); ac > 0 || ac != 0 && ab == 0 && bc == 0 {
+						return fmt.Errorf("%w: not transitive, cmp(%v, %v) = %d, cmp(%v, %v) = %d, cmp(%v, %v) = %d",
+							ErrComparator, a, b, ab, b, c, bc, a, c, ac)
+					}
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%w: not transitive", ErrComparator)
+}
+
+func sign(c int) int {
+	if c < 0 {
+		return -1
+	} else if c > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,196 @@
+package orderedmap
+
+// Cursor is a position in an OrderedMap which can move in both directions.
+// It remembers the path from the root, so Next and Prev don't search from the root again.
+// Modifying the map by other means than the cursor itself invalidates the cursor,
+// it must be placed again by First, Last, SeekGE or SeekLE.
+type Cursor[K, V any] struct {
+	t *tree[K, V]
+	p path[K, V] // ancestors of n
+	n *node[K, V]
+}
+
+// Cursor returns a cursor which is not placed yet, call First, Last, SeekGE or SeekLE before using it.
+func (m *OrderedMap[K, V]) Cursor() *Cursor[K, V] {
+	return &Cursor[K, V]{t: &m.t}
+}
+
+// Valid reports whether the cursor is on a key-value.
+func (c *Cursor[K, V]) Valid() bool {
+	return c.n != nil
+}
+
+// Key returns the key under the cursor, or zero value if the cursor is not valid.
+func (c *Cursor[K, V]) Key() K {
+	k, _ := keyValue(c.n)
+	return k
+}
+
+// Value returns the value under the cursor, or zero value if the cursor is not valid.
+func (c *Cursor[K, V]) Value() V {
+	_, v := keyValue(c.n)
+	return v
+}
+
+// SetValue replaces the value under the cursor, it does nothing if the cursor is not valid.
+// O(1), or O(logN) if the node is shared with a snapshot
+func (c *Cursor[K, V]) SetValue(value V) {
+	if c.n != nil {
+		c.n = c.t.mutPath(&c.p, c.n)
+		c.n.value = value
+	}
+}
+
+// First moves to the minimum key, and reports whether the cursor is valid.
+// O(logN)
+func (c *Cursor[K, V]) First() bool {
+	c.p.n = 0
+	c.n = c.t.root
+	c.downLeft()
+	return c.n != nil
+}
+
+// Last moves to the maximum key, and reports whether the cursor is valid.
+// O(logN)
+func (c *Cursor[K, V]) Last() bool {
+	c.p.n = 0
+	c.n = c.t.root
+	c.downRight()
+	return c.n != nil
+}
+
+// SeekGE moves to the minimum key >= key, and reports whether there is such a key.
+// O(logN)
+func (c *Cursor[K, V]) SeekGE(key K) bool {
+	c.p.n = 0
+	c.n = nil
+	depth := 0
+	for n := c.t.root; n != nil; {
+		if c.t.cmp(n.key, key) >= 0 {
+			c.n, depth = n, c.p.n
+			c.p.push(n, false)
+			n = n.left
+		} else {
+			c.p.push(n, true)
+			n = n.right
+		}
+	}
+	c.p.n = depth
+	return c.n != nil
+}
+
+// SeekLE moves to the maximum key <= key, and reports whether there is such a key.
+// O(logN)
+func (c *Cursor[K, V]) SeekLE(key K) bool {
+	c.p.n = 0
+	c.n = nil
+	depth := 0
+	for n := c.t.root; n != nil; {
+		if c.t.cmp(n.key, key) <= 0 {
+			c.n, depth = n, c.p.n
+			c.p.push(n, true)
+			n = n.right
+		} else {
+			c.p.push(n, false)
+			n = n.left
+		}
+	}
+	c.p.n = depth
+	return c.n != nil
+}
+
+// Next moves to the next key in ASC, and reports whether the cursor is still valid.
+// O(1) amortized
+func (c *Cursor[K, V]) Next() bool {
+	if c.n == nil {
+		return false
+	}
+	if c.n.right != nil {
+		c.p.push(c.n, true)
+		c.n = c.n.right
+		c.downLeft()
+		return true
+	}
+	for c.p.n > 0 {
+		c.p.n--
+		if !c.p.right[c.p.n] {
+			c.n = c.p.nodes[c.p.n]
+			return true
+		}
+	}
+	c.n = nil
+	return false
+}
+
+// Prev moves to the next key in DESC, and reports whether the cursor is still valid.
+// O(1) amortized
+func (c *Cursor[K, V]) Prev() bool {
+	if c.n == nil {
+		return false
+	}
+	if c.n.left != nil {
+		c.p.push(c.n, false)
+		c.n = c.n.left
+		c.downRight()
+		return true
+	}
+	for c.p.n > 0 {
+		c.p.n--
+		if c.p.right[c.p.n] {
+			c.n = c.p.nodes[c.p.n]
+			return true
+		}
+	}
+	c.n = nil
+	return false
+}
+
+// Delete removes the key-value under the cursor and moves to the next key in ASC,
+// it reports whether the cursor is still valid.
+// O(logN)
+func (c *Cursor[K, V]) Delete() bool {
+	if c.n == nil {
+		return false
+	}
+	var next *node[K, V]
+	if c.n.right != nil {
+		for next = c.n.right; next.left != nil; next = next.left {
+		}
+	} else {
+		for i := c.p.n - 1; i >= 0; i-- {
+			if !c.p.right[i] {
+				next = c.p.nodes[i]
+				break
+			}
+		}
+	}
+	if next == nil {
+		c.t.deleteAt(&c.p, c.n)
+		c.n = nil
+		return false
+	}
+	// The fixup rotates the nodes on the path, so search the next key from the root again.
+	key := next.key
+	c.t.deleteAt(&c.p, c.n)
+	return c.SeekGE(key)
+}
+
+func (c *Cursor[K, V]) downLeft() {
+	if c.n == nil {
+		return
+	}
+	for c.n.left != nil {
+		c.p.push(c.n, false)
+		c.n = c.n.left
+	}
+}
+
+func (c *Cursor[K, V]) downRight() {
+	if c.n == nil {
+		return
+	}
+	for c.n.right != nil {
+		c.p.push(c.n, true)
+		c.n = c.n.right
+	}
+}
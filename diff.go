@@ -0,0 +1,107 @@
+package orderedmap
+
+import "iter"
+
+// DiffKind is the kind of a DiffEntry.
+type DiffKind int
+
+const (
+	Added   DiffKind = iota + 1 // the key is only in the map b of Diff
+	Removed                     // the key is only in the map a of Diff
+	Changed                     // the key is in both maps with different values
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Changed:
+		return "Changed"
+	}
+	return "DiffKind(?)"
+}
+
+// DiffEntry is a difference of a key between two maps.
+// Old is the value in the map a of Diff, the zero value for Added,
+// and New is the value in the map b, the zero value for Removed.
+type DiffEntry[K, V any] struct {
+	Kind DiffKind
+	Key  K
+	Old  V
+	New  V
+}
+
+// Diff returns an iterator over the differences from the map a to the map b in ASC of the keys.
+// The values are compared by equal, or by == if equal is nil, so it panics then if V is not comparable.
+// The subtrees shared by both maps are skipped, for example the map cloned from a Snapshot and the map
+// modified since then only differ in the paths to the modified keys.
+// The maps must not be modified during the iteration, diff a Snapshot's Clone to apply the differences onto a.
+// O(N+M), or O(DlogN) for maps sharing their nodes, D is the number of modified keys.
+func Diff[K, V any](a, b *OrderedMap[K, V], equal func(x, y V) bool) iter.Seq[DiffEntry[K, V]] {
+	if equal == nil {
+		equal = func(x, y V) bool {
+			return any(x) == any(y)
+		}
+	}
+	return func(yield func(DiffEntry[K, V]) bool) {
+		var ia, ib iterator[K, V]
+		a.t.first(&ia)
+		b.t.first(&ib)
+		for ia.n > 0 || ib.n > 0 {
+			if ia.n > 0 && ib.n > 0 && ia.stack[ia.n-1] == ib.stack[ib.n-1] {
+				// The left subtree of the top node is visited, so the node and its right subtree come next in both.
+				ia.n--
+				ib.n--
+				continue
+			}
+			c := 0
+			if ia.n == 0 {
+				c = 1
+			} else if ib.n > 0 {
+				c = a.t.cmp(ia.stack[ia.n-1].key, ib.stack[ib.n-1].key)
+			} else {
+				c = -1
+			}
+			var e DiffEntry[K, V]
+			switch {
+			case c < 0:
+				x := ia.next()
+				e = DiffEntry[K, V]{Kind: Removed, Key: x.key, Old: x.value}
+			case c > 0:
+				y := ib.next()
+				e = DiffEntry[K, V]{Kind: Added, Key: y.key, New: y.value}
+			default:
+				x, y := ia.next(), ib.next()
+				if equal(x.value, y.value) {
+					continue
+				}
+				e = DiffEntry[K, V]{Kind: Changed, Key: y.key, Old: x.value, New: y.value}
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// ApplyDiff replays the differences onto m: the Added and Changed keys are put with the New value,
+// and the Removed keys are deleted.
+// O(DlogN), D is the number of differences.
+func (m *OrderedMap[K, V]) ApplyDiff(diff iter.Seq[DiffEntry[K, V]]) {
+	for e := range diff {
+		if e.Kind == Removed {
+			m.t.delete(e.Key)
+		} else {
+			m.t.put(e.Key, e.New)
+		}
+	}
+}
+
+// ApplyDiff replays the differences under the write lock, see OrderedMap.ApplyDiff.
+func (m *SyncOrderedMap[K, V]) ApplyDiff(diff iter.Seq[DiffEntry[K, V]]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.ApplyDiff(diff)
+}
@@ -0,0 +1,46 @@
+package orderedmap
+
+import (
+	"cmp"
+	"math"
+)
+
+// ZeroPolicy decides whether a float map keeps -0 and +0 as the same key.
+type ZeroPolicy int
+
+const (
+	ZerosEqual    ZeroPolicy = iota // -0 and +0 are the same key, the one put first is kept
+	ZerosDistinct                   // -0 and +0 are different keys, -0 < +0
+)
+
+// CompareFloat returns a total order of floats for the maps:
+// NaN is less than any other number and all NaNs are the same key, the same as cmp.Compare,
+// and -0 and +0 are compared by the zero policy.
+// Comparing floats by < alone makes NaN neither less nor greater than any key, which breaks the tree.
+func CompareFloat[T ~float32 | ~float64](zeros ZeroPolicy) func(a, b T) int {
+	if zeros != ZerosDistinct {
+		return cmp.Compare[T]
+	}
+	return func(a, b T) int {
+		if c := cmp.Compare(a, b); c != 0 || a != 0 {
+			return c
+		}
+		sa, sb := math.Signbit(float64(a)), math.Signbit(float64(b))
+		if sa == sb {
+			return 0
+		} else if sa {
+			return -1
+		}
+		return 1
+	}
+}
+
+// NewFloat32Zeros returns a map ordered by CompareFloat with the zero policy.
+func NewFloat32Zeros(zeros ZeroPolicy) *Float32 {
+	return NewFunc[float32, interface{}](CompareFloat[float32](zeros))
+}
+
+// NewFloat64Zeros returns a map ordered by CompareFloat with the zero policy.
+func NewFloat64Zeros(zeros ZeroPolicy) *Float64 {
+	return NewFunc[float64, interface{}](CompareFloat[float64](zeros))
+}
@@ -0,0 +1,91 @@
+package orderedmap
+
+import "iter"
+
+// All returns an iterator over the key-values in ASC.
+// The iterator streams from the tree, the map must not be modified during the iteration.
+// O(logN) to start, O(1) amortized per key-value
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var it iterator[K, V]
+		for m.t.first(&it); it.n > 0; {
+			n := it.next()
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key-values in DESC.
+// O(logN) to start, O(1) amortized per key-value
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var it iterator[K, V]
+		for m.t.last(&it); it.n > 0; {
+			n := it.prev()
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// AscendFrom returns an iterator over the key-values which >= from in ASC.
+// O(logN) to start, O(1) amortized per key-value
+func (m *OrderedMap[K, V]) AscendFrom(from K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var it iterator[K, V]
+		for m.t.seekGE(&it, from); it.n > 0; {
+			n := it.next()
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// DescendFrom returns an iterator over the key-values which <= from in DESC.
+// O(logN) to start, O(1) amortized per key-value
+func (m *OrderedMap[K, V]) DescendFrom(from K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var it iterator[K, V]
+		for m.t.seekLE(&it, from); it.n > 0; {
+			n := it.prev()
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Between returns an iterator over the key-values in [minKey, maxKey] in ASC.
+// MinKey & MaxKey are all closed interval, the same as Range.
+// O(logN) to start, O(1) amortized per key-value
+func (m *OrderedMap[K, V]) Between(minKey, maxKey K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var it iterator[K, V]
+		for m.t.seekGE(&it, minKey); it.n > 0; {
+			n := it.next()
+			if m.t.cmp(n.key, maxKey) > 0 || !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// BetweenFunc returns an iterator over the key-values with where(key) == 0 in ASC.
+// where must be ascending in the order: negative for the keys before the section, zero inside it,
+// and positive after it, for example comparing a field of the keys, see Pair2First.
+// O(logN) to start, O(1) amortized per key-value
+func (m *OrderedMap[K, V]) BetweenFunc(where func(key K) int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var it iterator[K, V]
+		for m.t.seekFunc(&it, where); it.n > 0; {
+			n := it.next()
+			if where(n.key) > 0 || !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package orderedmap
+
+// The split and join operations of red-black trees, see "Just Join for Parallel Ordered Sets" by Blelloch et al.
+// They work on subtrees together with their black height, the number of black nodes
+// on any path from the subtree root down to nil, counting the root if it is black.
+// All the nodes they modify are copied by mut first, so the snapshots stay unchanged.
+
+// blackHeight returns the black height of the subtree rooted at n.
+// O(logN)
+func blackHeight[K, V any](n *node[K, V]) int {
+	h := 0
+	for ; n != nil; n = n.left {
+		if !n.red {
+			h++
+		}
+	}
+	return h
+}
+
+// blacken returns n colored black and its black height.
+func (t *tree[K, V]) blacken(n *node[K, V], h int) (*node[K, V], int) {
+	if isRed(n) {
+		n = t.mut(n)
+		n.red = false
+		h++
+	}
+	return n, h
+}
+
+// join returns the subtree of l, the key-value and r, all keys of l must be < key and all keys of r > key.
+// The returned root is black.
+// O(|lh - rh|)
+func (t *tree[K, V]) join(l *node[K, V], lh int, key K, value V, r *node[K, V], rh int) (*node[K, V], int) {
+	l, lh = t.blacken(l, lh)
+	r, rh = t.blacken(r, rh)
+	if lh > rh {
+		return t.blacken(t.joinRight(l, lh, key, value, r, rh), lh)
+	}
+	if lh < rh {
+		return t.blacken(t.joinLeft(l, lh, key, value, r, rh), rh)
+	}
+	return &node[K, V]{left: l, right: r, key: key, value: value, size: size(l) + size(r) + 1, gen: t.gen}, lh + 1
+}
+
+// joinRight walks down the right spine of l to the black node of black height rh, and puts the key-value
+// there as a red node with r as its right subtree, then fixes the red-red violation on the way up.
+// The returned subtree has the black height lh, its root may be red with a red right child.
+func (t *tree[K, V]) joinRight(l *node[K, V], lh int, key K, value V, r *node[K, V], rh int) *node[K, V] {
+	if !isRed(l) && lh == rh {
+		return &node[K, V]{left: l, right: r, key: key, value: value, size: size(l) + size(r) + 1, gen: t.gen, red: true}
+	}
+	l = t.mut(l)
+	if !l.red {
+		lh--
+	}
+	l.right = t.joinRight(l.right, lh, key, value, r, rh)
+	l.size = size(l.left) + size(l.right) + 1
+	if !l.red && isRed(l.right) && isRed(l.right.right) {
+		l.right.right = t.mut(l.right.right)
+		l.right.right.red = false
+		return rotateLeft(l)
+	}
+	return l
+}
+
+// joinLeft is the mirror of joinRight.
+func (t *tree[K, V]) joinLeft(l *node[K, V], lh int, key K, value V, r *node[K, V], rh int) *node[K, V] {
+	if !isRed(r) && lh == rh {
+		return &node[K, V]{left: l, right: r, key: key, value: value, size: size(l) + size(r) + 1, gen: t.gen, red: true}
+	}
+	r = t.mut(r)
+	if !r.red {
+		rh--
+	}
+	r.left = t.joinLeft(l, lh, key, value, r.left, rh)
+	r.size = size(r.left) + size(r.right) + 1
+	if !r.red && isRed(r.left) && isRed(r.left.left) {
+		r.left.left = t.mut(r.left.left)
+		r.left.left.red = false
+		return rotateRight(r)
+	}
+	return r
+}
+
+// join2 is join without a key in the middle, all keys of l must be < all keys of r.
+// O(logN)
+func (t *tree[K, V]) join2(l *node[K, V], lh int, r *node[K, V], rh int) (*node[K, V], int) {
+	if r == nil {
+		return t.blacken(l, lh)
+	}
+	first := r
+	for first.left != nil {
+		first = first.left
+	}
+	_, _, r, rh = t.split(r, rh, first.key, true)
+	return t.join(l, lh, first.key, first.value, r, rh)
+}
+
+// split splits the subtree rooted at n of black height h into the keys < key, or <= key if orEqual, and the others.
+// O(logN)
+func (t *tree[K, V]) split(n *node[K, V], h int, key K, orEqual bool) (l *node[K, V], lh int, r *node[K, V], rh int) {
+	if n == nil {
+		return nil, 0, nil, 0
+	}
+	if !n.red {
+		h--
+	}
+	if c := t.cmp(n.key, key); c < 0 || c == 0 && orEqual {
+		rl, rlh, rr, rrh := t.split(n.right, h, key, orEqual)
+		l, lh = t.join(n.left, h, n.key, n.value, rl, rlh)
+		return l, lh, rr, rrh
+	}
+	ll, llh, lr, lrh := t.split(n.left, h, key, orEqual)
+	r, rh = t.join(lr, lrh, n.key, n.value, n.right, h)
+	return ll, llh, r, rh
+}
+
+// setRoot makes n the root, colored black.
+func (t *tree[K, V]) setRoot(n *node[K, V]) {
+	t.root, _ = t.blacken(n, 0)
+}
+
+// deleteRange deletes the keys in [minKey, maxKey] and returns the number of deleted keys.
+// O(logN)
+func (t *tree[K, V]) deleteRange(minKey, maxKey K) int {
+	count := t.rankLE(maxKey) - t.rank(minKey)
+	if count <= 0 {
+		return 0
+	}
+	l, lh, r, rh := t.split(t.root, blackHeight(t.root), minKey, false)
+	_, _, r, rh = t.split(r, rh, maxKey, true)
+	l, _ = t.join2(l, lh, r, rh)
+	t.setRoot(l)
+	return count
+}
+
+// deleteBefore deletes the keys < key and returns the number of deleted keys.
+// O(logN)
+func (t *tree[K, V]) deleteBefore(key K) int {
+	count := t.rank(key)
+	if count == 0 {
+		return 0
+	}
+	_, _, r, _ := t.split(t.root, blackHeight(t.root), key, false)
+	t.setRoot(r)
+	return count
+}
+
+// deleteAfter deletes the keys > key and returns the number of deleted keys.
+// O(logN)
+func (t *tree[K, V]) deleteAfter(key K) int {
+	count := t.len() - t.rankLE(key)
+	if count == 0 {
+		return 0
+	}
+	l, _, _, _ := t.split(t.root, blackHeight(t.root), key, true)
+	t.setRoot(l)
+	return count
+}
+
+// concat joins the keys of o into t if they are all < or all > the keys of t, and reports whether they were joined.
+// The nodes of o must not be modified by its owner later, they are copied by t before being modified.
+// O(logN)
+func (t *tree[K, V]) concat(o *tree[K, V]) bool {
+	if o.root == nil {
+		return true
+	}
+	// The nodes of o may have the generation of t, such as 0 of two new maps, so t starts a new one.
+	t.share()
+	switch {
+	case t.root == nil:
+		t.root = o.root
+	case t.cmp(t.max().key, o.min().key) < 0:
+		root, _ := t.join2(t.root, blackHeight(t.root), o.root, blackHeight(o.root))
+		t.setRoot(root)
+	case t.cmp(o.max().key, t.min().key) < 0:
+		root, _ := t.join2(o.root, blackHeight(o.root), t.root, blackHeight(t.root))
+		t.setRoot(root)
+	default:
+		return false
+	}
+	return true
+}
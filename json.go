@@ -0,0 +1,228 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalJSON encodes the map as a JSON object with the keys in ASC.
+// The keys are encoded as JSON strings: strings as is, integers and floats in decimal, []byte in base64,
+// and the types implementing encoding.TextMarshaler by MarshalText.
+// Use JSONPairs when the keys must keep their JSON type.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for k, v := range m.All() {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		s, err := marshalKey(k)
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+		buf.WriteByte(':')
+		if b, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object written by MarshalJSON and puts the key-values into the map,
+// the same as encoding/json does for a Go map. A zero OrderedMap created by the decoder is ordered
+// by the natural order of K, which must be an ordered type then.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if err := m.initCmp(); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("orderedmap: cannot unmarshal %v into an OrderedMap, want a JSON object", tok)
+	}
+	for dec.More() {
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		var key K
+		if err = unmarshalKey(tok.(string), &key); err != nil {
+			return err
+		}
+		var value V
+		if err = dec.Decode(&value); err != nil {
+			return err
+		}
+		m.t.put(key, value)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// JSONPairs encodes the map as a JSON array of {"key": k, "value": v} in ASC,
+// so the keys keep their JSON type, for example numbers round-trip exactly.
+// For example: json.Marshal(orderedmap.JSONPairs[int64, string]{M: m})
+type JSONPairs[K, V any] struct {
+	M *OrderedMap[K, V]
+}
+
+type jsonPair[K, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+func (p JSONPairs[K, V]) MarshalJSON() ([]byte, error) {
+	if p.M == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for k, v := range p.M.All() {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		b, err := json.Marshal(jsonPair[K, V]{Key: k, Value: v})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array written by MarshalJSON and puts the key-values into p.M.
+// If p.M is nil, a new map ordered by the natural order of K is allocated.
+func (p *JSONPairs[K, V]) UnmarshalJSON(data []byte) error {
+	if p.M == nil {
+		p.M = &OrderedMap[K, V]{}
+	}
+	if err := p.M.initCmp(); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("orderedmap: cannot unmarshal %v into JSONPairs, want a JSON array", tok)
+	}
+	for dec.More() {
+		var pair jsonPair[K, V]
+		if err = dec.Decode(&pair); err != nil {
+			return err
+		}
+		p.M.t.put(pair.Key, pair.Value)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// MarshalJSON encodes the map under the read lock, see OrderedMap.MarshalJSON.
+func (m *SyncOrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.MarshalJSON()
+}
+
+// UnmarshalJSON decodes the map under the write lock, see OrderedMap.UnmarshalJSON.
+func (m *SyncOrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.UnmarshalJSON(data)
+}
+
+// MarshalJSON encodes the snapshot, see OrderedMap.MarshalJSON.
+func (s *Snapshot[K, V]) MarshalJSON() ([]byte, error) {
+	return s.m.MarshalJSON()
+}
+
+func marshalKey[K any](key K) (string, error) {
+	switch k := any(key).(type) {
+	case encoding.TextMarshaler:
+		b, err := k.MarshalText()
+		return string(b), err
+	case string:
+		return k, nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(k), nil
+	}
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return "", errors.New("orderedmap: cannot marshal nil key")
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", &json.UnsupportedTypeError{Type: v.Type()}
+}
+
+func unmarshalKey[K any](s string, key *K) error {
+	switch k := any(key).(type) {
+	case encoding.TextUnmarshaler:
+		return k.UnmarshalText([]byte(s))
+	case *[]byte:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return fmt.Errorf("orderedmap: invalid key %q: %w", s, err)
+		}
+		*k = b
+		return nil
+	}
+	v := reflect.ValueOf(key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("orderedmap: invalid key %q: %w", s, err)
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("orderedmap: invalid key %q: %w", s, err)
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("orderedmap: invalid key %q: %w", s, err)
+		}
+		v.SetFloat(n)
+		return nil
+	}
+	return &json.UnsupportedTypeError{Type: v.Type()}
+}
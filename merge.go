@@ -0,0 +1,179 @@
+package orderedmap
+
+import "math/bits"
+
+// Union returns a new map of the keys in m or other, m and other are not changed.
+// For a key in both, the value is resolve(key, value in m, value in other),
+// or the value in other if resolve is nil, the same as putting other into m.
+// O(N+M)
+func (m *OrderedMap[K, V]) Union(other *OrderedMap[K, V], resolve func(key K, a, b V) V) *OrderedMap[K, V] {
+	resolve = resolveOrLast(resolve)
+	pairs := make([]KeyValue[K, V], 0, m.Len()+other.Len())
+	merge(&m.t, &other.t, func(a, b *node[K, V]) {
+		switch {
+		case b == nil:
+			pairs = append(pairs, KeyValue[K, V]{Key: a.key, Value: a.value})
+		case a == nil:
+			pairs = append(pairs, KeyValue[K, V]{Key: b.key, Value: b.value})
+		default:
+			pairs = append(pairs, KeyValue[K, V]{Key: a.key, Value: resolve(a.key, a.value, b.value)})
+		}
+	})
+	return m.fromSlice(pairs)
+}
+
+// Intersection returns a new map of the keys in both m and other, m and other are not changed.
+// The value is resolve(key, value in m, value in other), or the value in other if resolve is nil.
+// O(N+M), or O(MlogN) if one map is much smaller than the other.
+func (m *OrderedMap[K, V]) Intersection(other *OrderedMap[K, V], resolve func(key K, a, b V) V) *OrderedMap[K, V] {
+	resolve = resolveOrLast(resolve)
+	var pairs []KeyValue[K, V]
+	switch {
+	case fewer(other.Len(), m.Len()):
+		for k, v := range other.All() {
+			if x := m.t.find(k); x != nil {
+				pairs = append(pairs, KeyValue[K, V]{Key: x.key, Value: resolve(x.key, x.value, v)})
+			}
+		}
+	case fewer(m.Len(), other.Len()):
+		for k, v := range m.All() {
+			if x := other.t.find(k); x != nil {
+				pairs = append(pairs, KeyValue[K, V]{Key: k, Value: resolve(k, v, x.value)})
+			}
+		}
+	default:
+		merge(&m.t, &other.t, func(a, b *node[K, V]) {
+			if a != nil && b != nil {
+				pairs = append(pairs, KeyValue[K, V]{Key: a.key, Value: resolve(a.key, a.value, b.value)})
+			}
+		})
+	}
+	return m.fromSlice(pairs)
+}
+
+// Difference returns a new map of the key-values of m whose keys are not in other, m and other are not changed.
+// O(N+M), or O(NlogM) if m is much smaller than other.
+func (m *OrderedMap[K, V]) Difference(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	var pairs []KeyValue[K, V]
+	if fewer(m.Len(), other.Len()) {
+		for k, v := range m.All() {
+			if other.t.find(k) == nil {
+				pairs = append(pairs, KeyValue[K, V]{Key: k, Value: v})
+			}
+		}
+		return m.fromSlice(pairs)
+	}
+	merge(&m.t, &other.t, func(a, b *node[K, V]) {
+		if b == nil {
+			pairs = append(pairs, KeyValue[K, V]{Key: a.key, Value: a.value})
+		}
+	})
+	return m.fromSlice(pairs)
+}
+
+// SymmetricDifference returns a new map of the key-values whose keys are in exactly one of m and other,
+// m and other are not changed.
+// O(N+M)
+func (m *OrderedMap[K, V]) SymmetricDifference(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	var pairs []KeyValue[K, V]
+	merge(&m.t, &other.t, func(a, b *node[K, V]) {
+		if a == nil {
+			pairs = append(pairs, KeyValue[K, V]{Key: b.key, Value: b.value})
+		} else if b == nil {
+			pairs = append(pairs, KeyValue[K, V]{Key: a.key, Value: a.value})
+		}
+	})
+	return m.fromSlice(pairs)
+}
+
+// Union returns a new concurrent map, see OrderedMap.Union. It works on snapshots of m and other.
+func (m *SyncOrderedMap[K, V]) Union(other *SyncOrderedMap[K, V], resolve func(key K, a, b V) V) *SyncOrderedMap[K, V] {
+	a, b := m.Snapshot(), other.Snapshot()
+	return &SyncOrderedMap[K, V]{m: *a.m.Union(&b.m, resolve)}
+}
+
+// Intersection returns a new concurrent map, see OrderedMap.Intersection. It works on snapshots of m and other.
+func (m *SyncOrderedMap[K, V]) Intersection(other *SyncOrderedMap[K, V], resolve func(key K, a, b V) V) *SyncOrderedMap[K, V] {
+	a, b := m.Snapshot(), other.Snapshot()
+	return &SyncOrderedMap[K, V]{m: *a.m.Intersection(&b.m, resolve)}
+}
+
+// Difference returns a new concurrent map, see OrderedMap.Difference. It works on snapshots of m and other.
+func (m *SyncOrderedMap[K, V]) Difference(other *SyncOrderedMap[K, V]) *SyncOrderedMap[K, V] {
+	a, b := m.Snapshot(), other.Snapshot()
+	return &SyncOrderedMap[K, V]{m: *a.m.Difference(&b.m)}
+}
+
+// SymmetricDifference returns a new concurrent map, see OrderedMap.SymmetricDifference.
+// It works on snapshots of m and other.
+func (m *SyncOrderedMap[K, V]) SymmetricDifference(other *SyncOrderedMap[K, V]) *SyncOrderedMap[K, V] {
+	a, b := m.Snapshot(), other.Snapshot()
+	return &SyncOrderedMap[K, V]{m: *a.m.SymmetricDifference(&b.m)}
+}
+
+// fewer reports whether putting or finding m keys one by one in a map of n keys, O(mlogn),
+// is cheaper than a merge of both, O(n+m).
+func fewer(m, n int) bool {
+	return m*bits.Len(uint(n)) < n
+}
+
+func resolveOrLast[K, V any](resolve func(key K, a, b V) V) func(key K, a, b V) V {
+	if resolve != nil {
+		return resolve
+	}
+	return func(key K, a, b V) V {
+		return b
+	}
+}
+
+// clone returns a copy of m with new nodes, m is only read, unlike Snapshot which gives m a new generation.
+// O(N)
+func (m *OrderedMap[K, V]) clone() *OrderedMap[K, V] {
+	res := &OrderedMap[K, V]{t: m.t.like()}
+	var it iterator[K, V]
+	m.t.first(&it)
+	res.t.root = res.t.build(m.t.len(), func() (K, V) {
+		n := it.next()
+		return n.key, n.value
+	})
+	return res
+}
+
+// fromSlice returns a new map with the comparator of m built from pairs in ASC, the keys are not copied.
+// O(N)
+func (m *OrderedMap[K, V]) fromSlice(pairs []KeyValue[K, V]) *OrderedMap[K, V] {
+	res := &OrderedMap[K, V]{t: m.t.like()}
+	res.t.root = res.t.buildSlice(pairs)
+	return res
+}
+
+// merge walks a and b together in ASC by the comparator of a, and calls fn with the nodes of each key,
+// x is nil if the key is only in b and y is nil if it is only in a.
+// O(N+M)
+func merge[K, V any](a, b *tree[K, V], fn func(x, y *node[K, V])) {
+	var ia, ib iterator[K, V]
+	a.first(&ia)
+	b.first(&ib)
+	x, y := ia.next(), ib.next()
+	for x != nil || y != nil {
+		c := 0
+		if x == nil {
+			c = 1
+		} else if y == nil {
+			c = -1
+		} else {
+			c = a.cmp(x.key, y.key)
+		}
+		switch {
+		case c < 0:
+			fn(x, nil)
+			x = ia.next()
+		case c > 0:
+			fn(nil, y)
+			y = ib.next()
+		default:
+			fn(x, y)
+			x, y = ia.next(), ib.next()
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// MultiMap is an ordered map where a key may hold several values, kept in insertion order.
+// It is an OrderedMap from K to []V, so a key costs one node however many values it holds.
+type MultiMap[K, V any] struct {
+	m OrderedMap[K, []V]
+	n int // number of key-value pairs
+}
+
+// NewMultiMap returns an empty multimap ordered by the natural order of K.
+func NewMultiMap[K cmp.Ordered, V any]() *MultiMap[K, V] {
+	return NewMultiMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewMultiMapFunc returns an empty multimap ordered by cmp.
+func NewMultiMapFunc[K, V any](cmp func(a, b K) int) *MultiMap[K, V] {
+	return &MultiMap[K, V]{m: OrderedMap[K, []V]{t: tree[K, []V]{cmp: cmp}}}
+}
+
+// Put appends the value to the values of the key.
+// O(logN)
+func (m *MultiMap[K, V]) Put(key K, value V) {
+	var values []V
+	if n := m.m.t.find(key); n != nil {
+		values = n.value
+	}
+	m.m.t.put(key, append(values, value))
+	m.n++
+}
+
+// GetAll returns a copy of the values of the key in insertion order, or nil if not found.
+// O(logN) + O(K), K is the number of values of the key.
+func (m *MultiMap[K, V]) GetAll(key K) []V {
+	if n := m.m.t.find(key); n != nil {
+		return slices.Clone(n.value)
+	}
+	return nil
+}
+
+// DeleteOne deletes the first inserted value of the key and returns it.
+// O(logN)
+func (m *MultiMap[K, V]) DeleteOne(key K) (V, bool) {
+	n := m.m.t.find(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	value := n.value[0]
+	if len(n.value) == 1 {
+		m.m.t.delete(key)
+	} else {
+		// The slices of a MultiMap are never shared, clear the slot so the value can be collected.
+		var zero V
+		n.value[0] = zero
+		m.m.t.put(key, n.value[1:])
+	}
+	m.n--
+	return value, true
+}
+
+// DeleteAll deletes all values of the key and returns the number of deleted values.
+// O(logN)
+func (m *MultiMap[K, V]) DeleteAll(key K) int {
+	_, values, _ := m.m.t.delete(key)
+	m.n -= len(values)
+	return len(values)
+}
+
+// Count returns the number of values of the key.
+// O(logN)
+func (m *MultiMap[K, V]) Count(key K) int {
+	values, _ := m.m.Get(key)
+	return len(values)
+}
+
+// O(logN)
+func (m *MultiMap[K, V]) Contains(key K) bool {
+	return m.m.t.find(key) != nil
+}
+
+// Keys returns the distinct keys in ASC.
+// O(N)
+func (m *MultiMap[K, V]) Keys() []K {
+	return m.m.Keys()
+}
+
+// Range returns the key-value pairs in [minKey, maxKey] in ASC of the keys,
+// the values of a key in insertion order.
+// MinKey & MaxKey are all closed interval.
+// O(logN) + O(K), K is the number of pairs between minKey and maxKey.
+func (m *MultiMap[K, V]) Range(minKey, maxKey K) []KeyValue[K, V] {
+	var res []KeyValue[K, V]
+	for k, v := range m.Between(minKey, maxKey) {
+		res = append(res, KeyValue[K, V]{Key: k, Value: v})
+	}
+	return res
+}
+
+// All returns an iterator over every key-value pair in ASC of the keys, the values of a key in insertion order.
+func (m *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return flatten(m.m.All(), false)
+}
+
+// Backward returns an iterator over every key-value pair in the reverse order of All.
+func (m *MultiMap[K, V]) Backward() iter.Seq2[K, V] {
+	return flatten(m.m.Backward(), true)
+}
+
+// Between returns an iterator over the key-value pairs in [minKey, maxKey] in the order of All.
+func (m *MultiMap[K, V]) Between(minKey, maxKey K) iter.Seq2[K, V] {
+	return flatten(m.m.Between(minKey, maxKey), false)
+}
+
+// Len returns the number of key-value pairs.
+// O(1)
+func (m *MultiMap[K, V]) Len() int {
+	return m.n
+}
+
+// KeyLen returns the number of distinct keys.
+// O(1)
+func (m *MultiMap[K, V]) KeyLen() int {
+	return m.m.Len()
+}
+
+// O(1)
+func (m *MultiMap[K, V]) IsEmpty() bool {
+	return m.n == 0
+}
+
+// Deprecated: only for debugging, unstable function
+func (m *MultiMap[K, V]) String() string {
+	return m.m.String()
+}
+
+func flatten[K, V any](seq iter.Seq2[K, []V], reverse bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, values := range seq {
+			for i := range values {
+				if reverse {
+					i = len(values) - 1 - i
+				}
+				if !yield(k, values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -1,103 +1,394 @@
 package orderedmap
 
 import (
-	"github.com/shengmingzhu/datastructures/pair"
-	"github.com/shengmingzhu/datastructures/rbtree"
+	"bytes"
+	"cmp"
+	"errors"
+	"reflect"
+	"time"
 )
 
-type Any OrderedMap
+// OrderedMap is an ordered map from K to V, sorted by the comparator given at construction.
+// The zero value is not usable, create it by New or NewFunc.
+type OrderedMap[K, V any] struct {
+	t tree[K, V]
+}
+
+type KeyValue[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// ErrOverlap is returned by Join if the key ranges of the maps overlap.
+var ErrOverlap = errors.New("orderedmap: the key ranges overlap")
+
+// New returns an empty map ordered by the natural order of K.
+func New[K cmp.Ordered, V any]() *OrderedMap[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc returns an empty map ordered by cmp.
+// cmp(a, b) must return a negative number when a < b, zero when a == b, and a positive number when a > b.
+func NewFunc[K, V any](cmp func(a, b K) int) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{t: tree[K, V]{cmp: cmp}}
+}
+
+// Reverse returns the reverse order of cmp, for example NewFunc[int, V](Reverse(cmp.Compare[int])) is a map in DESC.
+func Reverse[K any](cmp func(a, b K) int) func(a, b K) int {
+	return func(a, b K) int {
+		return cmp(b, a)
+	}
+}
+
+// Get returns the value to key, or zero value if not found.
+// For example: if value, ok := t.Get(key); ok { value found }
+// O(logN)
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if n := m.t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores the key-value pair into RbTree.
+// 1. If there is already a same key in RbTree, it will replace the value.
+// 2. Otherwise, it will insert a new node with the key-value.
+// O(logN)
+func (m *OrderedMap[K, V]) Put(key K, value V) {
+	m.t.put(key, value)
+}
+
+// O(logN)
+func (m *OrderedMap[K, V]) Delete(key K) {
+	m.t.delete(key)
+}
+
+// Min returns the key-value to the minimum key, or zero values if the tree is empty.
+// For example: if key, value := t.Min(); !t.IsEmpty() { found }
+// O(logN)
+func (m *OrderedMap[K, V]) Min() (K, V) {
+	return keyValue(m.t.min())
+}
+
+// Max returns the key-value to the maximum key, or zero values if the tree is empty.
+// For example: if key, value := t.Max(); !t.IsEmpty() { found }
+// O(logN)
+func (m *OrderedMap[K, V]) Max() (K, V) {
+	return keyValue(m.t.max())
+}
+
+// Floor returns the key-value to the maximum key <= key.
+// For example: if k, v, ok := t.Floor(key); ok { found }
+// O(logN)
+func (m *OrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	return keyValueOk(m.t.floor(key))
+}
+
+// Ceiling returns the key-value to the minimum key >= key.
+// O(logN)
+func (m *OrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return keyValueOk(m.t.ceiling(key))
+}
 
-type OrderedMap interface {
-	Get(interface{}) (interface{}, bool) // O(logN)
-	Put(interface{}, interface{})        // O(logN)
-	Delete(interface{})                  // O(logN)
+// Lower returns the key-value to the maximum key < key.
+// O(logN)
+func (m *OrderedMap[K, V]) Lower(key K) (K, V, bool) {
+	return keyValueOk(m.t.lower(key))
+}
 
-	Keys() []interface{}   // O(N)
-	Values() []interface{} // O(N)
+// Higher returns the key-value to the minimum key > key.
+// O(logN)
+func (m *OrderedMap[K, V]) Higher(key K) (K, V, bool) {
+	return keyValueOk(m.t.higher(key))
+}
 
-	Min() (interface{}, interface{})    // O(logN)
-	Max() (interface{}, interface{})    // O(logN)
-	PopMin() (interface{}, interface{}) // O(logN). Call Min() and Delete(), and return Min
-	PopMax() (interface{}, interface{}) // O(logN). Call Max() and Delete(), and return Max
+// PopMin will delete the min node and return it.
+// O(logN)
+func (m *OrderedMap[K, V]) PopMin() (K, V) {
+	n := m.t.min()
+	if n == nil {
+		return keyValue(n)
+	}
+	key, value, _ := m.t.delete(n.key)
+	return key, value
+}
 
-	RangeAll() []pair.Pair                            // O(N)
-	RangeAllDesc() []pair.Pair                        // O(N)
-	Range(minKey, maxKey interface{}) []pair.Pair     // O(logN) + O(K), K is the number of keys between minKey and maxKey.
-	RangeDesc(minKey, maxKey interface{}) []pair.Pair // O(logN) + O(K), K is the number of keys between minKey and maxKey.
-	RangeN(num int, key interface{}) []pair.Pair      // O(logN) + O(K)
-	RangeDescN(num int, key interface{}) []pair.Pair  // O(logN) + O(K)
+// PopMax will delete the max node and return it.
+// O(logN)
+func (m *OrderedMap[K, V]) PopMax() (K, V) {
+	n := m.t.max()
+	if n == nil {
+		return keyValue(n)
+	}
+	key, value, _ := m.t.delete(n.key)
+	return key, value
+}
 
-	Len() int      // O(1)
-	IsEmpty() bool // O(1)
+// DeleteRange deletes the keys in [minKey, maxKey] and returns the number of deleted keys.
+// MinKey & MaxKey are all closed interval.
+// The tree is split and joined instead of deleting the keys one by one.
+// O(logN)
+func (m *OrderedMap[K, V]) DeleteRange(minKey, maxKey K) int {
+	return m.t.deleteRange(minKey, maxKey)
+}
 
-	// String is very useful when debugging
-	// Example: fmt.Println(t) will print as follows:
-	/*
-	 *                               [ 6]B
-	 *           [ 3]B                                   [13]R
-	 * [ 2]R               [ 5]R               [ 8]B               [15]B
-	 *                                              [10]R
-	 */
-	// Deprecated: only for debugging, unstable function
-	String() string
+// DeleteBefore deletes the keys < key and returns the number of deleted keys.
+// O(logN)
+func (m *OrderedMap[K, V]) DeleteBefore(key K) int {
+	return m.t.deleteBefore(key)
 }
 
-func NewAny(cmp rbtree.CmpFunc) Any {
-	return rbtree.New(cmp)
+// DeleteAfter deletes the keys > key and returns the number of deleted keys.
+// O(logN)
+func (m *OrderedMap[K, V]) DeleteAfter(key K) int {
+	return m.t.deleteAfter(key)
 }
 
-func NewByte() *Byte {
-	return &Byte{m: rbtree.New(cmpByte)}
+// Split returns a map of the keys < key and a map of the keys >= key, m is not changed.
+// The new maps share the nodes with m and each other as snapshots do, so only O(logN) nodes are created.
+// O(logN)
+func (m *OrderedMap[K, V]) Split(key K) (left, right *OrderedMap[K, V]) {
+	t := m.t
+	m.t.share()
+	t.share()
+	l, _, r, _ := t.split(t.root, blackHeight(t.root), key, false)
+	left = &OrderedMap[K, V]{t: t.like()}
+	left.t.gen = t.gen
+	left.t.setRoot(l)
+	left.t.share()
+	right = &OrderedMap[K, V]{t: t.like()}
+	right.t.gen = t.gen
+	right.t.setRoot(r)
+	right.t.share()
+	return left, right
 }
 
-func NewInt() *Int {
-	return &Int{m: rbtree.New(cmpInt)}
+// Join puts all key-values of other into m, other is not changed.
+// The keys of other must be all less or all greater than the keys of m, otherwise ErrOverlap is returned.
+// m shares the nodes of other by a Snapshot of it, so other must not be used by other goroutines meanwhile.
+// O(logN)
+func (m *OrderedMap[K, V]) Join(other *OrderedMap[K, V]) error {
+	if !m.t.concat(&other.Snapshot().m.t) {
+		return ErrOverlap
+	}
+	return nil
 }
 
-func NewInt8() *Int8 {
-	return &Int8{m: rbtree.New(cmpInt8)}
+// O(N)
+func (m *OrderedMap[K, V]) Keys() []K {
+	res := make([]K, 0, m.t.len())
+	var it iterator[K, V]
+	for m.t.first(&it); it.n > 0; {
+		res = append(res, it.next().key)
+	}
+	return res
 }
 
-func NewInt16() *Int16 {
-	return &Int16{m: rbtree.New(cmpInt16)}
+// O(N)
+func (m *OrderedMap[K, V]) Values() []V {
+	res := make([]V, 0, m.t.len())
+	var it iterator[K, V]
+	for m.t.first(&it); it.n > 0; {
+		res = append(res, it.next().value)
+	}
+	return res
 }
 
-func NewInt32() *Int32 {
-	return &Int32{m: rbtree.New(cmpInt32)}
+// RangeAll traversals in ASC
+// O(N)
+func (m *OrderedMap[K, V]) RangeAll() []KeyValue[K, V] {
+	res := make([]KeyValue[K, V], 0, m.t.len())
+	var it iterator[K, V]
+	for m.t.first(&it); it.n > 0; {
+		n := it.next()
+		res = append(res, KeyValue[K, V]{Key: n.key, Value: n.value})
+	}
+	return res
 }
 
-func NewInt64() *Int64 {
-	return &Int64{m: rbtree.New(cmpInt64)}
+// RangeAllDesc traversals in DESC
+// O(N)
+func (m *OrderedMap[K, V]) RangeAllDesc() []KeyValue[K, V] {
+	res := make([]KeyValue[K, V], 0, m.t.len())
+	var it iterator[K, V]
+	for m.t.last(&it); it.n > 0; {
+		n := it.prev()
+		res = append(res, KeyValue[K, V]{Key: n.key, Value: n.value})
+	}
+	return res
 }
 
-func NewRune() *Rune {
-	return &Rune{m: rbtree.New(cmpRune)}
+// Range traversals in [minKey, maxKey] in ASC
+// MinKey & MaxKey are all closed interval.
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (m *OrderedMap[K, V]) Range(minKey, maxKey K) []KeyValue[K, V] {
+	res := make([]KeyValue[K, V], 0)
+	var it iterator[K, V]
+	for m.t.seekGE(&it, minKey); it.n > 0; {
+		n := it.next()
+		if m.t.cmp(n.key, maxKey) > 0 {
+			break
+		}
+		res = append(res, KeyValue[K, V]{Key: n.key, Value: n.value})
+	}
+	return res
 }
 
-func NewString() *String {
-	return &String{m: rbtree.New(cmpString)}
+// RangeDesc traversals in [minKey, maxKey] in DESC
+// MinKey & MaxKey are all closed interval.
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (m *OrderedMap[K, V]) RangeDesc(minKey, maxKey K) []KeyValue[K, V] {
+	res := make([]KeyValue[K, V], 0)
+	var it iterator[K, V]
+	for m.t.seekLE(&it, maxKey); it.n > 0; {
+		n := it.prev()
+		if m.t.cmp(n.key, minKey) < 0 {
+			break
+		}
+		res = append(res, KeyValue[K, V]{Key: n.key, Value: n.value})
+	}
+	return res
 }
 
-func NewUint() *Uint {
-	return &Uint{m: rbtree.New(cmpUint)}
+// RangeN get num key-values which >= key in ASC
+// O(logN) + O(num)
+func (m *OrderedMap[K, V]) RangeN(num int, key K) []KeyValue[K, V] {
+	res := make([]KeyValue[K, V], 0, min(max(num, 0), m.t.len()))
+	var it iterator[K, V]
+	for m.t.seekGE(&it, key); it.n > 0 && len(res) < num; {
+		n := it.next()
+		res = append(res, KeyValue[K, V]{Key: n.key, Value: n.value})
+	}
+	return res
 }
 
-func NewUint8() *Uint8 {
-	return &Uint8{m: rbtree.New(cmpUint8)}
+// RangeDescN get num key-values which <= key in DESC
+// O(logN) + O(num)
+func (m *OrderedMap[K, V]) RangeDescN(num int, key K) []KeyValue[K, V] {
+	res := make([]KeyValue[K, V], 0, min(max(num, 0), m.t.len()))
+	var it iterator[K, V]
+	for m.t.seekLE(&it, key); it.n > 0 && len(res) < num; {
+		n := it.prev()
+		res = append(res, KeyValue[K, V]{Key: n.key, Value: n.value})
+	}
+	return res
 }
 
-func NewUint16() *Uint16 {
-	return &Uint16{m: rbtree.New(cmpUint16)}
+// O(1)
+func (m *OrderedMap[K, V]) Len() int {
+	return m.t.len()
 }
 
-func NewUint32() *Uint32 {
-	return &Uint32{m: rbtree.New(cmpUint32)}
+// O(1)
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+	return m.t.len() == 0
+}
+
+// String is very useful when debugging
+// Example: fmt.Println(t) will print as follows:
+/*
+ *                [6]B
+ *      [3]B                     [13]R
+ * [2]R      [5]R      [8]B           [15]B
+ *                          [10]R
+ */
+// Deprecated: only for debugging, unstable function
+func (m *OrderedMap[K, V]) String() string {
+	return m.t.String()
+}
+
+func keyValue[K, V any](n *node[K, V]) (K, V) {
+	if n == nil {
+		var k K
+		var v V
+		return k, v
+	}
+	return n.key, n.value
+}
+
+func keyValueOk[K, V any](n *node[K, V]) (K, V, bool) {
+	k, v := keyValue(n)
+	return k, v, n != nil
+}
+
+var errNoCmp = errors.New("orderedmap: the key type has no natural order, create the map by NewFunc before decoding")
+
+// initCmp gives a zero OrderedMap, such as one allocated by a decoder, the natural order of K.
+func (m *OrderedMap[K, V]) initCmp() error {
+	if m.t.cmp != nil {
+		return nil
+	}
+	if m.t.cmp = orderedCmp[K](); m.t.cmp == nil {
+		return errNoCmp
+	}
+	return nil
 }
 
-func NewUint64() *Uint64 {
-	return &Uint64{m: rbtree.New(cmpUint64)}
+// orderedCmp returns the comparator of the natural order of K, or nil if K is not an ordered type.
+// time.Time is ordered by CompareTime.
+func orderedCmp[K any]() func(a, b K) int {
+	var c interface{}
+	switch any(*new(K)).(type) {
+	case int:
+		c = cmp.Compare[int]
+	case int8:
+		c = cmp.Compare[int8]
+	case int16:
+		c = cmp.Compare[int16]
+	case int32:
+		c = cmp.Compare[int32]
+	case int64:
+		c = cmp.Compare[int64]
+	case uint:
+		c = cmp.Compare[uint]
+	case uint8:
+		c = cmp.Compare[uint8]
+	case uint16:
+		c = cmp.Compare[uint16]
+	case uint32:
+		c = cmp.Compare[uint32]
+	case uint64:
+		c = cmp.Compare[uint64]
+	case uintptr:
+		c = cmp.Compare[uintptr]
+	case float32:
+		c = cmp.Compare[float32]
+	case float64:
+		c = cmp.Compare[float64]
+	case string:
+		c = cmp.Compare[string]
+	case []byte:
+		c = bytes.Compare
+	case time.Duration:
+		c = cmp.Compare[time.Duration]
+	case time.Time:
+		c = CompareTime
+	default:
+		return reflectCmp[K]()
+	}
+	return c.(func(a, b K) int)
 }
 
-func NewUintptr() *Uintptr {
-	return &Uintptr{m: rbtree.New(cmpUintptr)}
+// reflectCmp compares the named ordered types, such as type ID int64, through reflect.
+func reflectCmp[K any]() func(a, b K) int {
+	switch reflect.TypeFor[K]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		}
+	case reflect.String:
+		return func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}
+	}
+	return nil
 }
@@ -0,0 +1,172 @@
+package orderedmap
+
+import "unicode/utf8"
+
+// The prefix queries of the maps keyed by string, such as String.
+// A key has the prefix if its head of as many runes as the prefix is equal to the prefix by the comparator
+// of the map, so they follow the order of the map, for example a case-insensitive one or DESC.
+// The keys with a prefix are contiguous in the byte order and the case folding orders. In Natural they are not,
+// so the keys sharing the head of the prefix before its first digit are scanned, see NewStringCollator.
+
+// PrefixRange returns the key-values whose keys start with prefix in the order of the map.
+// For example: orderedmap.PrefixRange(m, "usr/")
+// O(logN) + O(K), K is the number of keys with the prefix, or the number of keys sharing its head in Natural.
+func PrefixRange[V any](m *OrderedMap[string, V], prefix string) []KeyValue[string, V] {
+	var res []KeyValue[string, V]
+	p, block := prefixBlock(m, prefix)
+	for k, v := range m.BetweenFunc(block.where) {
+		if p.match(k) {
+			res = append(res, KeyValue[string, V]{Key: k, Value: v})
+		}
+	}
+	return res
+}
+
+// PrefixCount returns the number of keys starting with prefix.
+// O(logN), or O(logN) + O(K) in Natural, K is the number of keys sharing the head of the prefix.
+func PrefixCount[V any](m *OrderedMap[string, V], prefix string) int {
+	if m.t.prefixHead != nil {
+		return len(PrefixRange(m, prefix))
+	}
+	lo, hi := prefixRanks(m, prefix)
+	return hi - lo
+}
+
+// DeletePrefix deletes the keys starting with prefix and returns the number of deleted keys.
+// O(logN), or O(KlogN) in Natural, K is the number of keys sharing the head of the prefix.
+func DeletePrefix[V any](m *OrderedMap[string, V], prefix string) int {
+	if m.t.prefixHead != nil {
+		kvs := PrefixRange(m, prefix)
+		for _, kv := range kvs {
+			m.Delete(kv.Key)
+		}
+		return len(kvs)
+	}
+	lo, hi := prefixRanks(m, prefix)
+	if hi <= lo {
+		return 0
+	}
+	return m.t.deleteRange(m.t.at(lo).key, m.t.at(hi-1).key)
+}
+
+// LongestPrefixOf returns the key-value of the longest key which is a prefix of s, such as the route of a path.
+// The keys between the answer and s all start with the answer, so it is searched from the key nearest to s:
+// if that key is not a prefix of s, s is shortened to the head it shares with the key and searched again.
+// A tree has no trie links to skip these steps, each of them needs a key sharing a shorter head with s
+// that is nearer to s than the answer, so there are usually none or one.
+// O((L+1)logN), L is the number of times s is shortened, at most the number of runes of s.
+// In Natural, the heads of s are looked up one by one from the longest, O((L+1)logN), L is the length of s.
+func LongestPrefixOf[V any](m *OrderedMap[string, V], s string) (string, V, bool) {
+	if m.t.prefixHead != nil {
+		return longestHeadOf(m, s)
+	}
+	// The heads of s are before s in ASC and after it in DESC.
+	before := m.t.cmp("", s) <= 0
+	for {
+		var n *node[string, V]
+		if before {
+			n = m.t.floor(s)
+		} else {
+			n = m.t.ceiling(s)
+		}
+		if n == nil {
+			break
+		}
+		if newPrefix(m.t.cmp, n.key).match(s) {
+			return n.key, n.value, true
+		}
+		// A key which is a prefix of s and is not nearer than n.key is also a prefix of n.key,
+		// so the answer is a prefix of the common head of s and n.key.
+		i := commonHead(m.t.cmp, s, n.key)
+		if i >= len(s) {
+			break
+		}
+		s = s[:i]
+	}
+	var zero V
+	return "", zero, false
+}
+
+// longestHeadOf returns the key-value of the longest head of s in m by a lookup for each head,
+// as the keys equal by Natural are equal byte by byte.
+func longestHeadOf[V any](m *OrderedMap[string, V], s string) (string, V, bool) {
+	for i := len(s); i >= 0; i-- {
+		if n := m.t.find(s[:i]); n != nil && newPrefix(m.t.cmp, n.key).match(s) {
+			return n.key, n.value, true
+		}
+	}
+	var zero V
+	return "", zero, false
+}
+
+// prefixBlock returns the prefix and the contiguous block of keys containing all the keys with it,
+// which are the same unless the map has a prefixHead.
+func prefixBlock[V any](m *OrderedMap[string, V], prefix string) (p, block prefix) {
+	p = newPrefix(m.t.cmp, prefix)
+	if m.t.prefixHead == nil {
+		return p, p
+	}
+	return p, newPrefix(m.t.cmp, m.t.prefixHead(prefix))
+}
+
+// prefixRanks returns the number of keys before the keys with the prefix, and the number of keys up to them.
+func prefixRanks[V any](m *OrderedMap[string, V], prefix string) (lo, hi int) {
+	p := newPrefix(m.t.cmp, prefix)
+	lo = m.t.rankFunc(func(key string) bool { return p.where(key) < 0 })
+	hi = m.t.rankFunc(func(key string) bool { return p.where(key) <= 0 })
+	return lo, hi
+}
+
+type prefix struct {
+	s     string
+	runes int // number of runes of s, or -1 if s is not valid UTF-8, then it is matched byte by byte
+	cmp   func(a, b string) int
+}
+
+func newPrefix(cmp func(a, b string) int, s string) prefix {
+	runes := -1
+	if utf8.ValidString(s) {
+		runes = utf8.RuneCountInString(s)
+	}
+	return prefix{s: s, runes: runes, cmp: cmp}
+}
+
+// match reports whether key starts with the prefix.
+func (p prefix) match(key string) bool {
+	if p.runes < 0 {
+		return len(key) >= len(p.s) && p.cmp(key[:len(p.s)], p.s) == 0
+	}
+	i := 0
+	for r := 0; r < p.runes; r++ {
+		if i >= len(key) {
+			return false
+		}
+		_, w := utf8.DecodeRuneInString(key[i:])
+		i += w
+	}
+	return p.cmp(key[:i], p.s) == 0
+}
+
+// where returns 0 if key starts with the prefix, otherwise the order of key to the prefix, see BetweenFunc.
+func (p prefix) where(key string) int {
+	if p.match(key) {
+		return 0
+	}
+	return p.cmp(key, p.s)
+}
+
+// commonHead returns the length in bytes of the longest head of a whose runes are equal
+// to the runes of b one by one by cmp.
+func commonHead(cmp func(a, b string) int, a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		_, wa := utf8.DecodeRuneInString(a[i:])
+		_, wb := utf8.DecodeRuneInString(b[j:])
+		if cmp(a[i:i+wa], b[j:j+wb]) != 0 {
+			break
+		}
+		i += wa
+		j += wb
+	}
+	return i
+}
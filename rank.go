@@ -0,0 +1,35 @@
+package orderedmap
+
+import "math"
+
+// Rank returns the number of keys < key, which is also the index of key in RangeAll if key exists.
+// O(logN)
+func (m *OrderedMap[K, V]) Rank(key K) int {
+	return m.t.rank(key)
+}
+
+// Select returns the key-value to the i-th smallest key counting from 0, the same as RangeAll()[i].
+// For example: if k, v, ok := t.Select(0); ok { k is the minimum key }
+// O(logN)
+func (m *OrderedMap[K, V]) Select(i int) (K, V, bool) {
+	return keyValueOk(m.t.at(i))
+}
+
+// CountRange returns the number of keys in [minKey, maxKey].
+// MinKey & MaxKey are all closed interval, the same as Range.
+// O(logN)
+func (m *OrderedMap[K, V]) CountRange(minKey, maxKey K) int {
+	return max(m.t.rankLE(maxKey)-m.t.rank(minKey), 0)
+}
+
+// Percentile returns the key-value at the p-th percentile by the nearest-rank method, p is in [0, 100].
+// Percentile(0) is the minimum, Percentile(50) the median and Percentile(100) the maximum.
+// It returns false if the map is empty or p is out of range.
+// O(logN)
+func (m *OrderedMap[K, V]) Percentile(p float64) (K, V, bool) {
+	if !(p >= 0 && p <= 100) || m.t.len() == 0 {
+		return keyValueOk[K, V](nil)
+	}
+	i := int(math.Ceil(p/100*float64(m.t.len()))) - 1
+	return keyValueOk(m.t.at(max(i, 0)))
+}
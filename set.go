@@ -0,0 +1,203 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Set is an ordered set of K. It is an OrderedMap with struct{} values,
+// which take no memory in the nodes.
+type Set[K any] struct {
+	m OrderedMap[K, struct{}]
+}
+
+type (
+	IntSet    = Set[int]
+	StringSet = Set[string]
+)
+
+// NewSet returns an empty set ordered by the natural order of K.
+func NewSet[K cmp.Ordered]() *Set[K] {
+	return NewSetFunc[K](cmp.Compare[K])
+}
+
+// NewSetFunc returns an empty set ordered by cmp.
+func NewSetFunc[K any](cmp func(a, b K) int) *Set[K] {
+	return &Set[K]{m: OrderedMap[K, struct{}]{t: tree[K, struct{}]{cmp: cmp}}}
+}
+
+func NewIntSet() *IntSet {
+	return NewSet[int]()
+}
+
+func NewStringSet() *StringSet {
+	return NewSet[string]()
+}
+
+// Add adds the key and reports whether it was not in the set.
+// O(logN)
+func (s *Set[K]) Add(key K) bool {
+	n := s.m.Len()
+	s.m.t.put(key, struct{}{})
+	return s.m.Len() > n
+}
+
+// Remove removes the key and reports whether it was in the set.
+// O(logN)
+func (s *Set[K]) Remove(key K) bool {
+	_, _, ok := s.m.t.delete(key)
+	return ok
+}
+
+// O(logN)
+func (s *Set[K]) Contains(key K) bool {
+	return s.m.t.find(key) != nil
+}
+
+// Min returns the minimum key, ok is false if the set is empty.
+// O(logN)
+func (s *Set[K]) Min() (K, bool) {
+	return keyOk(s.m.t.min())
+}
+
+// Max returns the maximum key, ok is false if the set is empty.
+// O(logN)
+func (s *Set[K]) Max() (K, bool) {
+	return keyOk(s.m.t.max())
+}
+
+// Floor returns the maximum key <= key.
+// O(logN)
+func (s *Set[K]) Floor(key K) (K, bool) {
+	return keyOk(s.m.t.floor(key))
+}
+
+// Ceiling returns the minimum key >= key.
+// O(logN)
+func (s *Set[K]) Ceiling(key K) (K, bool) {
+	return keyOk(s.m.t.ceiling(key))
+}
+
+// Lower returns the maximum key < key.
+// O(logN)
+func (s *Set[K]) Lower(key K) (K, bool) {
+	return keyOk(s.m.t.lower(key))
+}
+
+// Higher returns the minimum key > key.
+// O(logN)
+func (s *Set[K]) Higher(key K) (K, bool) {
+	return keyOk(s.m.t.higher(key))
+}
+
+// Keys returns the keys in ASC.
+// O(N)
+func (s *Set[K]) Keys() []K {
+	return s.m.Keys()
+}
+
+// Range returns the keys in [minKey, maxKey] in ASC.
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (s *Set[K]) Range(minKey, maxKey K) []K {
+	var keys []K
+	for k := range s.m.Between(minKey, maxKey) {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// All returns an iterator over the keys in ASC.
+func (s *Set[K]) All() iter.Seq[K] {
+	return keysOf(s.m.All())
+}
+
+// Backward returns an iterator over the keys in DESC.
+func (s *Set[K]) Backward() iter.Seq[K] {
+	return keysOf(s.m.Backward())
+}
+
+// AscendFrom returns an iterator over the keys >= from in ASC.
+func (s *Set[K]) AscendFrom(from K) iter.Seq[K] {
+	return keysOf(s.m.AscendFrom(from))
+}
+
+// DescendFrom returns an iterator over the keys <= from in DESC.
+func (s *Set[K]) DescendFrom(from K) iter.Seq[K] {
+	return keysOf(s.m.DescendFrom(from))
+}
+
+// Between returns an iterator over the keys in [minKey, maxKey] in ASC.
+func (s *Set[K]) Between(minKey, maxKey K) iter.Seq[K] {
+	return keysOf(s.m.Between(minKey, maxKey))
+}
+
+// O(logN)
+func (s *Set[K]) Rank(key K) int {
+	return s.m.Rank(key)
+}
+
+// O(logN)
+func (s *Set[K]) Select(i int) (K, bool) {
+	k, _, ok := s.m.Select(i)
+	return k, ok
+}
+
+// Union returns a new set of the keys in s or other.
+// O(N+M)
+func (s *Set[K]) Union(other *Set[K]) *Set[K] {
+	return &Set[K]{m: *s.m.Union(&other.m, nil)}
+}
+
+// Intersection returns a new set of the keys in both s and other.
+// O(N+M), or O(MlogN) if one set is much smaller than the other.
+func (s *Set[K]) Intersection(other *Set[K]) *Set[K] {
+	return &Set[K]{m: *s.m.Intersection(&other.m, nil)}
+}
+
+// Difference returns a new set of the keys in s but not in other.
+// O(N+M), or O(NlogM) if s is much smaller than other.
+func (s *Set[K]) Difference(other *Set[K]) *Set[K] {
+	return &Set[K]{m: *s.m.Difference(&other.m)}
+}
+
+// SymmetricDifference returns a new set of the keys in exactly one of s and other.
+// O(N+M)
+func (s *Set[K]) SymmetricDifference(other *Set[K]) *Set[K] {
+	return &Set[K]{m: *s.m.SymmetricDifference(&other.m)}
+}
+
+// Clone returns a copy of the set.
+// O(N)
+func (s *Set[K]) Clone() *Set[K] {
+	return &Set[K]{m: *s.m.clone()}
+}
+
+// O(1)
+func (s *Set[K]) Len() int {
+	return s.m.Len()
+}
+
+// O(1)
+func (s *Set[K]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Deprecated: only for debugging, unstable function
+func (s *Set[K]) String() string {
+	return s.m.String()
+}
+
+func keyOk[K, V any](n *node[K, V]) (K, bool) {
+	k, _, ok := keyValueOk(n)
+	return k, ok
+}
+
+func keysOf[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package orderedmap
+
+import "iter"
+
+// Snapshot is an immutable version of an OrderedMap.
+// It shares the nodes with the map, the map copies a node before modifying it if it is shared,
+// so the snapshot stays valid and unchanged however the map is modified later.
+// A Snapshot is safe for concurrent use by multiple goroutines.
+type Snapshot[K, V any] struct {
+	m OrderedMap[K, V]
+}
+
+// Snapshot returns the current version of the map.
+// After a snapshot, the first modification of each node copies it, which costs O(logN) per Put or Delete.
+// O(1)
+func (m *OrderedMap[K, V]) Snapshot() *Snapshot[K, V] {
+	s := &Snapshot[K, V]{m: OrderedMap[K, V]{t: m.t}}
+	m.t.share()
+	return s
+}
+
+// Clone returns a new map starting from this version, modifying it does not change the snapshot.
+// O(1)
+func (s *Snapshot[K, V]) Clone() *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{t: s.m.t}
+	m.t.share()
+	return m
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Get(key K) (V, bool) {
+	return s.m.Get(key)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Min() (K, V) {
+	return s.m.Min()
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Max() (K, V) {
+	return s.m.Max()
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Floor(key K) (K, V, bool) {
+	return s.m.Floor(key)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Ceiling(key K) (K, V, bool) {
+	return s.m.Ceiling(key)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Lower(key K) (K, V, bool) {
+	return s.m.Lower(key)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Higher(key K) (K, V, bool) {
+	return s.m.Higher(key)
+}
+
+// O(N)
+func (s *Snapshot[K, V]) Keys() []K {
+	return s.m.Keys()
+}
+
+// O(N)
+func (s *Snapshot[K, V]) Values() []V {
+	return s.m.Values()
+}
+
+// O(N)
+func (s *Snapshot[K, V]) RangeAll() []KeyValue[K, V] {
+	return s.m.RangeAll()
+}
+
+// O(N)
+func (s *Snapshot[K, V]) RangeAllDesc() []KeyValue[K, V] {
+	return s.m.RangeAllDesc()
+}
+
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (s *Snapshot[K, V]) Range(minKey, maxKey K) []KeyValue[K, V] {
+	return s.m.Range(minKey, maxKey)
+}
+
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (s *Snapshot[K, V]) RangeDesc(minKey, maxKey K) []KeyValue[K, V] {
+	return s.m.RangeDesc(minKey, maxKey)
+}
+
+// O(logN) + O(num)
+func (s *Snapshot[K, V]) RangeN(num int, key K) []KeyValue[K, V] {
+	return s.m.RangeN(num, key)
+}
+
+// O(logN) + O(num)
+func (s *Snapshot[K, V]) RangeDescN(num int, key K) []KeyValue[K, V] {
+	return s.m.RangeDescN(num, key)
+}
+
+func (s *Snapshot[K, V]) All() iter.Seq2[K, V] {
+	return s.m.All()
+}
+
+func (s *Snapshot[K, V]) Backward() iter.Seq2[K, V] {
+	return s.m.Backward()
+}
+
+func (s *Snapshot[K, V]) AscendFrom(from K) iter.Seq2[K, V] {
+	return s.m.AscendFrom(from)
+}
+
+func (s *Snapshot[K, V]) DescendFrom(from K) iter.Seq2[K, V] {
+	return s.m.DescendFrom(from)
+}
+
+func (s *Snapshot[K, V]) Between(minKey, maxKey K) iter.Seq2[K, V] {
+	return s.m.Between(minKey, maxKey)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Rank(key K) int {
+	return s.m.Rank(key)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Select(i int) (K, V, bool) {
+	return s.m.Select(i)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) CountRange(minKey, maxKey K) int {
+	return s.m.CountRange(minKey, maxKey)
+}
+
+// O(logN)
+func (s *Snapshot[K, V]) Percentile(p float64) (K, V, bool) {
+	return s.m.Percentile(p)
+}
+
+// O(1)
+func (s *Snapshot[K, V]) Len() int {
+	return s.m.Len()
+}
+
+// O(1)
+func (s *Snapshot[K, V]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Deprecated: only for debugging, unstable function
+func (s *Snapshot[K, V]) String() string {
+	return s.m.String()
+}
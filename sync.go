@@ -0,0 +1,351 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+)
+
+// SyncOrderedMap is an OrderedMap safe for concurrent use, all methods are guarded by a RWMutex.
+// The slices returned by the range methods and the key-values yielded by the iterators
+// are a consistent view of the map at the time of the call. The iterators walk a Snapshot,
+// so writers are not blocked by the loop body.
+type SyncOrderedMap[K, V any] struct {
+	mu sync.RWMutex
+	m  OrderedMap[K, V]
+}
+
+// NewSync returns an empty concurrent map ordered by the natural order of K.
+func NewSync[K cmp.Ordered, V any]() *SyncOrderedMap[K, V] {
+	return NewSyncFunc[K, V](cmp.Compare[K])
+}
+
+// NewSyncFunc returns an empty concurrent map ordered by cmp.
+func NewSyncFunc[K, V any](cmp func(a, b K) int) *SyncOrderedMap[K, V] {
+	return &SyncOrderedMap[K, V]{m: OrderedMap[K, V]{t: tree[K, V]{cmp: cmp}}}
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Get(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Put(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Put(key, value)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Delete(key)
+}
+
+// GetOrPut returns the existing value for the key if present, otherwise it stores and returns the given value.
+// The loaded result is true if the value was loaded, false if stored.
+// O(logN)
+func (m *SyncOrderedMap[K, V]) GetOrPut(key K, value V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n := m.m.t.find(key); n != nil {
+		return n.value, true
+	}
+	m.m.t.put(key, value)
+	return value, false
+}
+
+// CompareAndSwap swaps the value for the key if the current value is equal to old,
+// and reports whether it was swapped. The values are compared by ==,
+// so it panics if V is not comparable, the same as sync.Map.
+// O(logN)
+func (m *SyncOrderedMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := m.m.t.find(key)
+	if n == nil || any(n.value) != any(old) {
+		return false
+	}
+	m.m.t.put(key, new)
+	return true
+}
+
+// CompareAndDelete deletes the key if its value is equal to old, and reports whether it was deleted.
+// The values are compared by ==, the same as CompareAndSwap.
+// O(logN)
+func (m *SyncOrderedMap[K, V]) CompareAndDelete(key K, old V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := m.m.t.find(key)
+	if n == nil || any(n.value) != any(old) {
+		return false
+	}
+	m.m.t.delete(key)
+	return true
+}
+
+// Update calls fn with the current value of the key and whether it exists, under the write lock.
+// If fn returns keep, the key is stored with the returned value, otherwise the key is deleted.
+// fn must not call the methods of m.
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Update(key K, fn func(value V, ok bool) (newValue V, keep bool)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := m.m.t.find(key)
+	_, value, ok := keyValueOk(n)
+	newValue, keep := fn(value, ok)
+	if keep {
+		m.m.t.put(key, newValue)
+	} else if ok {
+		m.m.t.delete(key)
+	}
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Min() (K, V) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Min()
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Max() (K, V) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Max()
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Floor(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Ceiling(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Lower(key K) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Lower(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Higher(key K) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Higher(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) PopMin() (K, V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.PopMin()
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) PopMax() (K, V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.PopMax()
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) DeleteRange(minKey, maxKey K) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.DeleteRange(minKey, maxKey)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) DeleteBefore(key K) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.DeleteBefore(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) DeleteAfter(key K) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.DeleteAfter(key)
+}
+
+// Split returns a concurrent map of the keys < key and one of the keys >= key, see OrderedMap.Split.
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Split(key K) (left, right *SyncOrderedMap[K, V]) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, r := m.m.Split(key)
+	return &SyncOrderedMap[K, V]{m: *l}, &SyncOrderedMap[K, V]{m: *r}
+}
+
+// Join puts all key-values of other into m, see OrderedMap.Join.
+// It locks other only to take a Snapshot, so joining two maps into each other does not deadlock.
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Join(other *SyncOrderedMap[K, V]) error {
+	s := other.Snapshot()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Join(&s.m)
+}
+
+// O(N)
+func (m *SyncOrderedMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Keys()
+}
+
+// O(N)
+func (m *SyncOrderedMap[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Values()
+}
+
+// O(N)
+func (m *SyncOrderedMap[K, V]) RangeAll() []KeyValue[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.RangeAll()
+}
+
+// O(N)
+func (m *SyncOrderedMap[K, V]) RangeAllDesc() []KeyValue[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.RangeAllDesc()
+}
+
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (m *SyncOrderedMap[K, V]) Range(minKey, maxKey K) []KeyValue[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Range(minKey, maxKey)
+}
+
+// O(logN) + O(K), K is the number of keys between minKey and maxKey.
+func (m *SyncOrderedMap[K, V]) RangeDesc(minKey, maxKey K) []KeyValue[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.RangeDesc(minKey, maxKey)
+}
+
+// O(logN) + O(num)
+func (m *SyncOrderedMap[K, V]) RangeN(num int, key K) []KeyValue[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.RangeN(num, key)
+}
+
+// O(logN) + O(num)
+func (m *SyncOrderedMap[K, V]) RangeDescN(num int, key K) []KeyValue[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.RangeDescN(num, key)
+}
+
+// Snapshot returns the current version of the map, it is immutable and needs no lock.
+// O(1)
+func (m *SyncOrderedMap[K, V]) Snapshot() *Snapshot[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Snapshot()
+}
+
+// All returns an iterator over the key-values in ASC.
+// It iterates a Snapshot taken when the iteration starts.
+func (m *SyncOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Snapshot().m.All()(yield)
+	}
+}
+
+// Backward returns an iterator over the key-values in DESC, on a Snapshot taken when the iteration starts.
+func (m *SyncOrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Snapshot().m.Backward()(yield)
+	}
+}
+
+// AscendFrom returns an iterator over the key-values which >= from in ASC, on a Snapshot taken when the iteration starts.
+func (m *SyncOrderedMap[K, V]) AscendFrom(from K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Snapshot().m.AscendFrom(from)(yield)
+	}
+}
+
+// DescendFrom returns an iterator over the key-values which <= from in DESC, on a Snapshot taken when the iteration starts.
+func (m *SyncOrderedMap[K, V]) DescendFrom(from K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Snapshot().m.DescendFrom(from)(yield)
+	}
+}
+
+// Between returns an iterator over the key-values in [minKey, maxKey] in ASC, on a Snapshot taken when the iteration starts.
+func (m *SyncOrderedMap[K, V]) Between(minKey, maxKey K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Snapshot().m.Between(minKey, maxKey)(yield)
+	}
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Rank(key K) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Rank(key)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Select(i int) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Select(i)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) CountRange(minKey, maxKey K) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.CountRange(minKey, maxKey)
+}
+
+// O(logN)
+func (m *SyncOrderedMap[K, V]) Percentile(p float64) (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Percentile(p)
+}
+
+// O(1)
+func (m *SyncOrderedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Len()
+}
+
+// O(1)
+func (m *SyncOrderedMap[K, V]) IsEmpty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.IsEmpty()
+}
+
+// Deprecated: only for debugging, unstable function
+func (m *SyncOrderedMap[K, V]) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.String()
+}
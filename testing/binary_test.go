@@ -0,0 +1,164 @@
+package orderedmap_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBinary(t *testing.T) {
+	Convey("MarshalBinary and UnmarshalBinary round-trip", t, func() {
+		Convey("Generic map of native types", func() {
+			m := orderedmap.New[int64, string]()
+			for i := int64(-500); i < 500; i++ {
+				m.Put(i*3, string(rune('a'+i%26+26)))
+			}
+			m.Put(math.MinInt64, "min")
+			m.Put(math.MaxInt64, "max")
+			b, err := m.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b[0], ShouldEqual, 1)
+
+			n := orderedmap.New[int64, string]()
+			n.Put(1, "dropped")
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			So(n.RangeAll(), ShouldResemble, m.RangeAll())
+			So(n.Len(), ShouldEqual, 1002)
+
+			n.Put(1, "one")
+			n.Delete(0)
+			So(n.Len(), ShouldEqual, 1002)
+			So(n.Rank(1), ShouldEqual, 501)
+		})
+
+		Convey("Typed map with interface values", func() {
+			m := orderedmap.NewString()
+			m.Put("b", 2)
+			m.Put("a", "one")
+			m.Put("c", nil)
+			m.Put("d", 1.5)
+			b, err := m.MarshalBinary()
+			So(err, ShouldBeNil)
+			n := orderedmap.NewString()
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			So(n.RangeAll(), ShouldResemble, m.RangeAll())
+		})
+
+		Convey("Floats, bools and []byte values", func() {
+			m := orderedmap.New[float64, []byte]()
+			m.Put(math.Inf(-1), []byte("x"))
+			m.Put(-0.5, nil)
+			m.Put(2.25, []byte{0, 1, 2})
+			b, _ := m.MarshalBinary()
+			n := orderedmap.New[float64, []byte]()
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			So(n.Keys(), ShouldResemble, []float64{math.Inf(-1), -0.5, 2.25})
+			v, _ := n.Get(2.25)
+			So(v, ShouldResemble, []byte{0, 1, 2})
+
+			f := orderedmap.New[float32, bool]()
+			f.Put(1.5, true)
+			f.Put(-2, false)
+			b, _ = f.MarshalBinary()
+			g := orderedmap.New[float32, bool]()
+			So(g.UnmarshalBinary(b), ShouldBeNil)
+			So(g.RangeAll(), ShouldResemble, f.RangeAll())
+		})
+
+		Convey("BinaryMarshaler and gob values", func() {
+			type point struct{ X, Y int }
+			byTime := func(a, b time.Time) int { return a.Compare(b) }
+			m := orderedmap.NewFunc[time.Time, point](byTime)
+			t0 := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+			for i := 0; i < 10; i++ {
+				m.Put(t0.Add(time.Duration(i)*time.Hour), point{i, -i})
+			}
+			b, err := m.MarshalBinary()
+			So(err, ShouldBeNil)
+			n := orderedmap.NewFunc[time.Time, point](byTime)
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			So(n.Len(), ShouldEqual, 10)
+			k, v := n.Max()
+			So(k.Equal(t0.Add(9*time.Hour)), ShouldEqual, true)
+			So(v, ShouldResemble, point{9, -9})
+		})
+
+		Convey("A different comparator falls back to Put", func() {
+			m := orderedmap.New[int, int]()
+			for i := 0; i < 100; i++ {
+				m.Put(i, i)
+			}
+			b, _ := m.MarshalBinary()
+			n := orderedmap.NewFunc[int, int](func(a, b int) int { return b - a })
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			k, _ := n.Min()
+			So(k, ShouldEqual, 99)
+			So(n.Len(), ShouldEqual, 100)
+		})
+
+		Convey("Empty map", func() {
+			b, err := orderedmap.NewInt().MarshalBinary()
+			So(err, ShouldBeNil)
+			So(len(b), ShouldBeLessThanOrEqualTo, 3)
+			n := orderedmap.NewInt()
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			So(n.IsEmpty(), ShouldEqual, true)
+		})
+
+		Convey("Corrupt data", func() {
+			m := orderedmap.New[int, string]()
+			m.Put(1, "a")
+			m.Put(2, "bb")
+			b, _ := m.MarshalBinary()
+			n := orderedmap.New[int, string]()
+			So(n.UnmarshalBinary(nil), ShouldNotBeNil)
+			So(n.UnmarshalBinary([]byte{2, 0}), ShouldNotBeNil)
+			So(n.UnmarshalBinary(b[:len(b)-1]), ShouldNotBeNil)
+			So(n.UnmarshalBinary(append(b, 0)), ShouldNotBeNil)
+			So(n.UnmarshalBinary([]byte{1, 0xff, 0xff, 0xff, 0x7f}), ShouldNotBeNil)
+			So(orderedmap.New[int8, int]().UnmarshalBinary([]byte{1, 1, 0x80, 0x02, 0}), ShouldNotBeNil)
+		})
+	})
+
+	Convey("Gob", t, func() {
+		type doc struct {
+			Name  string
+			Items *orderedmap.OrderedMap[uint32, string]
+		}
+		d := doc{Name: "d", Items: orderedmap.New[uint32, string]()}
+		d.Items.Put(3, "c")
+		d.Items.Put(1, "a")
+		var buf bytes.Buffer
+		So(gob.NewEncoder(&buf).Encode(d), ShouldBeNil)
+
+		var out doc
+		So(gob.NewDecoder(&buf).Decode(&out), ShouldBeNil)
+		So(out.Name, ShouldEqual, "d")
+		So(out.Items.RangeAll(), ShouldResemble, d.Items.RangeAll())
+
+		s := orderedmap.NewSync[uint32, string]()
+		b, err := d.Items.Snapshot().MarshalBinary()
+		So(err, ShouldBeNil)
+		So(s.UnmarshalBinary(b), ShouldBeNil)
+		c, _ := s.MarshalBinary()
+		So(c, ShouldResemble, b)
+	})
+}
+
+func BenchmarkNew_UnmarshalBinary(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	data, _ := m.MarshalBinary()
+	n := orderedmap.New[int, int]()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = n.UnmarshalBinary(data)
+	}
+}
@@ -0,0 +1,91 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"testing"
+)
+
+func TestFromSorted(t *testing.T) {
+	Convey("FromSortedSlice builds the map from sorted key-values", t, func() {
+		pairs := make([]orderedmap.Int64KeyValue, 1000)
+		for i := range pairs {
+			pairs[i] = orderedmap.Int64KeyValue{Key: int64(i * 2), Value: i}
+		}
+		m, err := orderedmap.FromSortedSlice(pairs)
+		So(err, ShouldBeNil)
+		So(m.Len(), ShouldEqual, 1000)
+		So(m.RangeAll(), ShouldResemble, pairs)
+		So(m.Rank(1001), ShouldEqual, 501)
+
+		m.Put(1, 1)
+		m.Delete(0)
+		k, _ := m.Min()
+		So(k, ShouldEqual, 1)
+
+		Convey("Unsorted and duplicate keys are rejected", func() {
+			_, err := orderedmap.FromSortedSlice([]orderedmap.IntKeyValue{{Key: 1}, {Key: 3}, {Key: 2}})
+			So(err, ShouldNotBeNil)
+			_, err = orderedmap.FromSortedSlice([]orderedmap.IntKeyValue{{Key: 1}, {Key: 1}})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Empty input", func() {
+			e, err := orderedmap.FromSortedSlice[string, int](nil)
+			So(err, ShouldBeNil)
+			So(e.IsEmpty(), ShouldEqual, true)
+			e.Put("a", 1)
+			So(e.Len(), ShouldEqual, 1)
+		})
+
+		Convey("FromSortedSeq", func() {
+			s, err := orderedmap.FromSortedSeq(m.All())
+			So(err, ShouldBeNil)
+			So(s.RangeAll(), ShouldResemble, m.RangeAll())
+
+			_, err = orderedmap.FromSortedSeq(m.Backward())
+			So(err, ShouldNotBeNil)
+			d, err := orderedmap.FromSortedSeqFunc(func(a, b int64) int { return int(b - a) }, m.Backward())
+			So(err, ShouldBeNil)
+			So(d.Len(), ShouldEqual, m.Len())
+		})
+	})
+}
+
+func TestBulkPut(t *testing.T) {
+	Convey("BulkPut is the same as Put in order", t, func() {
+		for _, size := range []int{0, 10, 1000} {
+			m := orderedmap.New[int, int]()
+			n := orderedmap.New[int, int]()
+			for i := 0; i < size; i++ {
+				k := rand.Intn(2000)
+				m.Put(k, i)
+				n.Put(k, i)
+			}
+			for _, count := range []int{1, 20, 3000} {
+				pairs := make([]orderedmap.KeyValue[int, int], count)
+				for i := range pairs {
+					pairs[i] = orderedmap.KeyValue[int, int]{Key: rand.Intn(2000), Value: -i}
+					n.Put(pairs[i].Key, pairs[i].Value)
+				}
+				input := append([]orderedmap.KeyValue[int, int](nil), pairs...)
+				m.BulkPut(pairs)
+				So(pairs, ShouldResemble, input)
+				So(m.RangeAll(), ShouldResemble, n.RangeAll())
+			}
+		}
+	})
+}
+
+func BenchmarkNew_FromSortedSlice(b *testing.B) {
+	b.StopTimer()
+	pairs := make([]orderedmap.KeyValue[int, int], rangeLenGeneric)
+	for i := range pairs {
+		pairs[i] = orderedmap.KeyValue[int, int]{Key: i, Value: i}
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = orderedmap.FromSortedSlice(pairs)
+	}
+}
@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapByte_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyByte_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyByte)
+	sl := make([]byte, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := byte(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkByte_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewByte()
@@ -276,6 +292,22 @@ func BenchmarkHashMapByte_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyByte_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyByte)
+	sl := make([]byte, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := byte(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkByte_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewByte()
@@ -308,6 +340,22 @@ func BenchmarkHashMapByte_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyByte_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyByte)
+	sl := make([]byte, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := byte(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkByte_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewByte()
@@ -360,3 +408,22 @@ func BenchmarkHashMapByte_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyByte_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyByte)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenByte; i++ {
+		key := byte(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyByte is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyByte(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(byte), key2.(byte))
+}
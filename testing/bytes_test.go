@@ -0,0 +1,150 @@
+package orderedmap_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestNewBytes(t *testing.T) {
+	Convey("Bytes map", t, func() {
+		m := orderedmap.NewBytes()
+		buf := []byte("user/2")
+		m.Put(buf, 2)
+		buf[5] = '1'
+		m.Put(buf, 1)
+		m.Put([]byte("user/10"), 10)
+		m.Put([]byte("group/1"), "g")
+		m.Put([]byte{0xff, 0x00}, "bin")
+		So(m.Len(), ShouldEqual, 5)
+
+		Convey("Keys are copied on insert", func() {
+			v, ok := m.Get([]byte("user/2"))
+			So(ok, ShouldEqual, true)
+			So(v, ShouldEqual, 2)
+			v, _ = m.Get([]byte("user/1"))
+			So(v, ShouldEqual, 1)
+			buf[0] = 'x'
+			_, ok = m.Get([]byte("user/1"))
+			So(ok, ShouldEqual, true)
+
+			b := orderedmap.NewBytes()
+			batch := make([]orderedmap.BytesKeyValue, 100)
+			for i := range batch {
+				batch[i] = orderedmap.BytesKeyValue{Key: []byte{'k', byte(i)}, Value: i}
+			}
+			b.BulkPut(batch)
+			b.BulkPut(batch[:1])
+			for i := range batch {
+				batch[i].Key[0] = 'z'
+			}
+			So(b.Len(), ShouldEqual, 100)
+			So(b.Keys()[0], ShouldResemble, []byte{'k', 0})
+			v, ok = b.Get([]byte{'k', 99})
+			So(ok, ShouldEqual, true)
+			So(v, ShouldEqual, 99)
+		})
+
+		Convey("Lookups do not allocate", func() {
+			key := []byte("user/10")
+			allocs := testing.AllocsPerRun(100, func() {
+				_, _ = m.Get(key)
+				_, _, _ = m.Floor(key)
+			})
+			So(allocs, ShouldEqual, 0)
+		})
+
+		Convey("PrefixScan", func() {
+			var keys []string
+			for k := range orderedmap.PrefixScan(m, []byte("user/")) {
+				keys = append(keys, string(k))
+			}
+			So(keys, ShouldResemble, []string{"user/1", "user/10", "user/2"})
+			keys = keys[:0]
+			for k := range orderedmap.PrefixScan(m, []byte{0xff}) {
+				keys = append(keys, string(k))
+			}
+			So(keys, ShouldResemble, []string{"\xff\x00"})
+			n := 0
+			for range orderedmap.PrefixScan(m, nil) {
+				n++
+			}
+			So(n, ShouldEqual, 5)
+		})
+
+		Convey("PrefixScan in DESC and in a custom order", func() {
+			d := orderedmap.NewBytesDesc()
+			f := orderedmap.NewBytesFunc(func(a, b []byte) int { return bytes.Compare(bytes.ToLower(a), bytes.ToLower(b)) })
+			for k := range m.All() {
+				d.Put(k, nil)
+				f.Put(bytes.ToUpper(k), nil)
+			}
+			var keys []string
+			for k := range orderedmap.PrefixScan(d, []byte("user/")) {
+				keys = append(keys, string(k))
+			}
+			So(keys, ShouldResemble, []string{"user/2", "user/10", "user/1"})
+			keys = keys[:0]
+			for k := range orderedmap.PrefixScan(f, []byte("user/1")) {
+				keys = append(keys, string(k))
+			}
+			So(keys, ShouldResemble, []string{"USER/1", "USER/10"})
+		})
+
+		Convey("JSON round-trip", func() {
+			b, err := json.Marshal(m)
+			So(err, ShouldBeNil)
+			So(strings.HasPrefix(string(b), `{"Z3JvdXAvMQ==":"g",`), ShouldEqual, true)
+			n := orderedmap.NewBytes()
+			So(json.Unmarshal(b, n), ShouldBeNil)
+			So(n.Keys(), ShouldResemble, m.Keys())
+			v, _ := n.Get([]byte{0xff, 0x00})
+			So(v, ShouldEqual, "bin")
+			So(json.Unmarshal([]byte(`{"!":1}`), n), ShouldNotBeNil)
+		})
+
+		Convey("Range on raw bytes", func() {
+			pairs := m.Range([]byte("group/"), []byte("user/1"))
+			So(len(pairs), ShouldEqual, 2)
+			So(string(pairs[1].Key), ShouldEqual, "user/1")
+		})
+
+		Convey("Binary round-trip", func() {
+			b, err := m.MarshalBinary()
+			So(err, ShouldBeNil)
+			n := orderedmap.NewBytes()
+			So(n.UnmarshalBinary(b), ShouldBeNil)
+			So(n.RangeAll(), ShouldResemble, m.RangeAll())
+		})
+	})
+
+	Convey("Zero Bytes map decoded from JSON pairs", t, func() {
+		var p orderedmap.JSONPairs[[]byte, int]
+		So(json.Unmarshal([]byte(`[{"key":"Yg==","value":2},{"key":"YQ==","value":1}]`), &p), ShouldBeNil)
+		So(p.M.Keys(), ShouldResemble, [][]byte{[]byte("a"), []byte("b")})
+
+		s := orderedmap.NewSyncBytes()
+		k := []byte("k")
+		s.Put(k, 1)
+		k[0] = 'z'
+		_, ok := s.Get([]byte("k"))
+		So(ok, ShouldEqual, true)
+	})
+}
+
+func BenchmarkBytes_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewBytes()
+	keys := make([][]byte, rangeLenGeneric)
+	for i := range keys {
+		keys[i] = []byte{byte(i >> 8), byte(i)}
+		m.Put(keys[i], i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(keys[i%rangeLenGeneric])
+	}
+}
@@ -0,0 +1,110 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestCollator(t *testing.T) {
+	Convey("ASCIIFold", t, func() {
+		m := orderedmap.NewStringCollator(orderedmap.ASCIIFold)
+		for _, k := range []string{"Zebra", "apple", "Banana", "cherry", "APPLE"} {
+			m.Put(k, k)
+		}
+		So(m.Keys(), ShouldResemble, []string{"apple", "Banana", "cherry", "Zebra"})
+		v, ok := m.Get("ZEBRA")
+		So(ok, ShouldEqual, true)
+		So(v, ShouldEqual, "Zebra")
+		v, _ = m.Get("Apple")
+		So(v, ShouldEqual, "APPLE")
+		So(len(m.Range("b", "CZ")), ShouldEqual, 2)
+	})
+
+	Convey("UnicodeFold", t, func() {
+		m := orderedmap.NewStringCollator(orderedmap.UnicodeFold)
+		for _, k := range []string{"Ωmega", "émile", "Straße", "kelvin", "ΣΟΦΙΑ"} {
+			m.Put(k, k)
+		}
+		for _, k := range []string{"ωMEGA", "ÉMILE", "straße", "KELVIN", "σοφια"} {
+			_, ok := m.Get(k)
+			So(ok, ShouldEqual, true)
+			So(orderedmap.UnicodeFold.Compare(k, strings.ToLower(k)), ShouldEqual, 0)
+		}
+		So(m.Len(), ShouldEqual, 5)
+		So(orderedmap.UnicodeFold.Compare("a", "B"), ShouldEqual, -1)
+		So(orderedmap.UnicodeFold.Compare("\xff", "\xfe"), ShouldEqual, 1)
+
+		Convey("Prefix queries follow the collation", func() {
+			So(orderedmap.PrefixCount(m, "ÉM"), ShouldEqual, 1)
+			So(orderedmap.PrefixRange(m, "STRASS"), ShouldBeNil)
+			So(orderedmap.PrefixRange(m, "STRA")[0].Key, ShouldEqual, "Straße")
+			k, _, ok := orderedmap.LongestPrefixOf(m, "KELVINS")
+			So(ok, ShouldEqual, true)
+			So(k, ShouldEqual, "kelvin")
+			So(orderedmap.DeletePrefix(m, "σο"), ShouldEqual, 1)
+		})
+	})
+
+	Convey("Natural", t, func() {
+		m := orderedmap.NewStringCollator(orderedmap.Natural)
+		for _, k := range []string{"file10", "file2", "file1", "file01", "file", "file2a", "file100", "img12.png", "img2.png"} {
+			m.Put(k, nil)
+		}
+		So(m.Keys(), ShouldResemble, []string{"file", "file1", "file01", "file2", "file2a", "file10", "file100", "img2.png", "img12.png"})
+		So(len(m.Range("file2", "file99")), ShouldEqual, 3)
+		So(orderedmap.PrefixCount(m, "file"), ShouldEqual, 7)
+		So(orderedmap.Natural.Compare("x99999999999999999999999", "x100000000000000000000000"), ShouldEqual, -1)
+		So(orderedmap.Natural.Compare("0", "00"), ShouldEqual, -1)
+
+		Convey("Prefixes with digits", func() {
+			m := orderedmap.NewStringCollator(orderedmap.Natural)
+			for _, k := range []string{"file1", "file01", "file1a", "file2", "file10", "file11"} {
+				m.Put(k, nil)
+			}
+			So(orderedmap.PrefixCount(m, "file1"), ShouldEqual, 4)
+			var keys []string
+			for _, kv := range orderedmap.PrefixRange(m, "file1") {
+				keys = append(keys, kv.Key)
+			}
+			So(keys, ShouldResemble, []string{"file1", "file1a", "file10", "file11"})
+			So(orderedmap.PrefixCount(m, "file0"), ShouldEqual, 1)
+			k, _, ok := orderedmap.LongestPrefixOf(m, "file10.txt")
+			So(ok, ShouldEqual, true)
+			So(k, ShouldEqual, "file10")
+			k, _, _ = orderedmap.LongestPrefixOf(m, "file12")
+			So(k, ShouldEqual, "file1")
+			So(orderedmap.DeletePrefix(m, "file1"), ShouldEqual, 4)
+			So(m.Keys(), ShouldResemble, []string{"file01", "file2"})
+		})
+	})
+
+	Convey("Pluggable collator", t, func() {
+		byLen := orderedmap.CollatorFunc(func(a, b string) int {
+			if len(a) != len(b) {
+				return len(a) - len(b)
+			}
+			return strings.Compare(a, b)
+		})
+		m := orderedmap.NewSyncStringCollator(byLen)
+		m.Put("ccc", 3)
+		m.Put("a", 1)
+		m.Put("bb", 2)
+		So(m.Keys(), ShouldResemble, []string{"a", "bb", "ccc"})
+	})
+}
+
+func BenchmarkCollator_UnicodeFold(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewStringCollator(orderedmap.UnicodeFold)
+	keys := make([]string, rangeLenGeneric)
+	for i := range keys {
+		keys[i] = strings.Repeat("Ω", i%7) + string(rune('a'+i%26)) + strings.Repeat("x", i%13)
+		m.Put(keys[i], i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(keys[i%rangeLenGeneric])
+	}
+}
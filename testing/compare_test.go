@@ -0,0 +1,98 @@
+package orderedmap_test
+
+import (
+	"cmp"
+	"errors"
+	"github.com/shengmingzhu/datastructures/rbtree"
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckComparator(t *testing.T) {
+	ints := []int{0, 1, -1, 42, math.MinInt, math.MaxInt, math.MinInt + 1, math.MaxInt - 1}
+
+	Convey("Built-in comparators are total orders", t, func() {
+		So(orderedmap.CheckComparator(cmp.Compare[int], ints), ShouldBeNil)
+		So(orderedmap.CheckComparator(orderedmap.Reverse(cmp.Compare[int]), ints), ShouldBeNil)
+		floats := []float64{0, math.Copysign(0, -1), math.NaN(), math.Inf(1), math.Inf(-1), 1.5, -1.5, math.MaxFloat64}
+		So(orderedmap.CheckComparator(orderedmap.CompareFloat[float64](orderedmap.ZerosEqual), floats), ShouldBeNil)
+		So(orderedmap.CheckComparator(orderedmap.CompareFloat[float64](orderedmap.ZerosDistinct), floats), ShouldBeNil)
+		strs := []string{"", "a", "A", "file2", "file10", "file02", "Straße", "STRASSE", "σ", "Σ", "ς", "\xff", "0", "00"}
+		for _, c := range []orderedmap.Collator{orderedmap.ASCIIFold, orderedmap.UnicodeFold, orderedmap.Natural} {
+			So(orderedmap.CheckComparator(c.Compare, strs), ShouldBeNil)
+		}
+		now := time.Now()
+		So(orderedmap.CheckComparator(orderedmap.CompareTime, []time.Time{now, now.Round(0), now.Add(1), {}}), ShouldBeNil)
+		pairs := []orderedmap.Pair2[int, string]{orderedmap.MakePair2(1, "b"), orderedmap.MakePair2(1, "a"), orderedmap.MakePair2(math.MinInt, "z")}
+		So(orderedmap.CheckComparator(orderedmap.Pair2[int, string].Compare, pairs), ShouldBeNil)
+	})
+
+	Convey("The Int map orders extreme keys", t, func() {
+		m := orderedmap.NewInt()
+		for _, k := range ints {
+			m.Put(k, nil)
+		}
+		So(m.Keys(), ShouldResemble, []int{math.MinInt, math.MinInt + 1, -1, 0, 1, 42, math.MaxInt - 1, math.MaxInt})
+	})
+
+	Convey("Broken comparators are reported", t, func() {
+		sub := func(a, b int) int { return a - b }
+		err := orderedmap.CheckComparator(sub, ints)
+		So(errors.Is(err, orderedmap.ErrComparator), ShouldEqual, true)
+		So(err.Error(), ShouldContainSubstring, "not antisymmetric")
+
+		less := func(a, b float64) int {
+			if a < b {
+				return -1
+			} else if a > b {
+				return 1
+			}
+			return 0
+		}
+		err = orderedmap.CheckComparator(less, []float64{1, math.NaN(), 2})
+		So(err.Error(), ShouldContainSubstring, "not transitive")
+
+		err = orderedmap.CheckComparator(func(a, b float64) int { return less(a, b) | 1 }, []float64{1})
+		So(err.Error(), ShouldContainSubstring, "not reflexive")
+
+		rock := func(a, b string) int {
+			beats := map[string]string{"rock": "scissors", "scissors": "paper", "paper": "rock"}
+			if a == b {
+				return 0
+			} else if beats[a] == b {
+				return 1
+			}
+			return -1
+		}
+		err = orderedmap.CheckComparator(rock, []string{"rock", "paper", "scissors"})
+		So(err.Error(), ShouldContainSubstring, "not transitive")
+		So(strings.Count(err.Error(), "cmp("), ShouldEqual, 3)
+	})
+
+	Convey("Untyped rbtree.CmpFunc", t, func() {
+		var c rbtree.CmpFunc = func(a, b interface{}) int {
+			return cmp.Compare(a.(int), b.(int))
+		}
+		samples := make([]interface{}, len(ints))
+		for i, k := range ints {
+			samples[i] = k
+		}
+		So(orderedmap.CheckComparator(c, samples), ShouldBeNil)
+		c = func(a, b interface{}) int { return a.(int) - b.(int) }
+		So(orderedmap.CheckComparator(c, samples), ShouldNotBeNil)
+	})
+}
+
+func BenchmarkCheckComparator(b *testing.B) {
+	samples := make([]int, 100)
+	for i := range samples {
+		samples[i] = i * 7919 % 101
+	}
+	for i := 0; i < b.N; i++ {
+		_ = orderedmap.CheckComparator(cmp.Compare[int], samples)
+	}
+}
@@ -0,0 +1,153 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCursor(t *testing.T) {
+	Convey("Cursor over keys 0, 2, 4, ..., 98", t, func() {
+		m := orderedmap.New[int, int]()
+		for i := 0; i < 100; i += 2 {
+			m.Put(i, i*10)
+		}
+		c := m.Cursor()
+		So(c.Valid(), ShouldEqual, false)
+
+		Convey("First and Next", func() {
+			i := 0
+			for ok := c.First(); ok; ok = c.Next() {
+				So(c.Key(), ShouldEqual, i)
+				So(c.Value(), ShouldEqual, i*10)
+				i += 2
+			}
+			So(i, ShouldEqual, 100)
+			So(c.Valid(), ShouldEqual, false)
+		})
+
+		Convey("Last and Prev", func() {
+			i := 98
+			for ok := c.Last(); ok; ok = c.Prev() {
+				So(c.Key(), ShouldEqual, i)
+				i -= 2
+			}
+			So(i, ShouldEqual, -2)
+		})
+
+		Convey("SeekGE and SeekLE", func() {
+			So(c.SeekGE(31), ShouldEqual, true)
+			So(c.Key(), ShouldEqual, 32)
+			So(c.SeekGE(32), ShouldEqual, true)
+			So(c.Key(), ShouldEqual, 32)
+			So(c.SeekLE(31), ShouldEqual, true)
+			So(c.Key(), ShouldEqual, 30)
+			So(c.SeekLE(-1), ShouldEqual, false)
+			So(c.SeekGE(99), ShouldEqual, false)
+			So(c.Key(), ShouldEqual, 0)
+			So(c.Value(), ShouldEqual, 0)
+		})
+
+		Convey("Next and Prev turn around", func() {
+			c.SeekGE(50)
+			So(c.Next(), ShouldEqual, true)
+			So(c.Key(), ShouldEqual, 52)
+			So(c.Prev(), ShouldEqual, true)
+			So(c.Prev(), ShouldEqual, true)
+			So(c.Key(), ShouldEqual, 48)
+		})
+
+		Convey("SetValue", func() {
+			c.SeekGE(10)
+			c.SetValue(-1)
+			v, _ := m.Get(10)
+			So(v, ShouldEqual, -1)
+		})
+
+		Convey("Delete moves to the next key", func() {
+			c.SeekGE(10)
+			So(c.Delete(), ShouldEqual, true)
+			So(c.Key(), ShouldEqual, 12)
+			_, ok := m.Get(10)
+			So(ok, ShouldEqual, false)
+			c.Last()
+			So(c.Delete(), ShouldEqual, false)
+			So(m.Len(), ShouldEqual, 48)
+		})
+
+		Convey("Delete while walking", func() {
+			for ok := c.First(); ok; {
+				if c.Key()%4 == 0 {
+					ok = c.Delete()
+				} else {
+					ok = c.Next()
+				}
+			}
+			So(m.Len(), ShouldEqual, 25)
+			for _, k := range m.Keys() {
+				So(k%4, ShouldEqual, 2)
+			}
+		})
+
+		Convey("Merge join", func() {
+			other := orderedmap.New[int, string]()
+			for i := 0; i < 100; i += 3 {
+				other.Put(i, "x")
+			}
+			var joined []int
+			a, b := m.Cursor(), other.Cursor()
+			for okA, okB := a.First(), b.First(); okA && okB; {
+				if a.Key() < b.Key() {
+					okA = a.SeekGE(b.Key())
+				} else if a.Key() > b.Key() {
+					okB = b.SeekGE(a.Key())
+				} else {
+					joined = append(joined, a.Key())
+					okA, okB = a.Next(), b.Next()
+				}
+			}
+			So(joined, ShouldResemble, []int{0, 6, 12, 18, 24, 30, 36, 42, 48, 54, 60, 66, 72, 78, 84, 90, 96})
+		})
+
+		Convey("EmptyMap", func() {
+			c := orderedmap.NewString().Cursor()
+			So(c.First(), ShouldEqual, false)
+			So(c.Last(), ShouldEqual, false)
+			So(c.Next(), ShouldEqual, false)
+			So(c.Prev(), ShouldEqual, false)
+			So(c.Delete(), ShouldEqual, false)
+			So(c.Key(), ShouldEqual, "")
+		})
+	})
+}
+
+func TestCursorRandomDelete(t *testing.T) {
+	Convey("Delete random keys by the cursor", t, func() {
+		m := orderedmap.New[int, int]()
+		hm := make(map[int]struct{})
+		rand.Seed(time.Now().UnixNano())
+		for i := 0; i < 1000; i++ {
+			key := rand.Intn(10000)
+			hm[key] = struct{}{}
+			m.Put(key, key)
+		}
+		c := m.Cursor()
+		for ok := c.First(); ok; {
+			if rand.Intn(2) == 0 {
+				delete(hm, c.Key())
+				ok = c.Delete()
+			} else {
+				ok = c.Next()
+			}
+		}
+		sl := make([]int, 0, len(hm))
+		for k := range hm {
+			sl = append(sl, k)
+		}
+		sort.Ints(sl)
+		So(m.Keys(), ShouldResemble, sl)
+	})
+}
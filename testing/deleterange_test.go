@@ -0,0 +1,74 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestDeleteRange(t *testing.T) {
+	Convey("Delete spans of keys", t, func() {
+		m := orderedmap.NewInt64()
+		for i := int64(0); i < 1000; i++ {
+			m.Put(i*10, i)
+		}
+
+		Convey("DeleteRange", func() {
+			So(m.DeleteRange(95, 200), ShouldEqual, 11)
+			So(m.Len(), ShouldEqual, 989)
+			k, _, _ := m.Floor(200)
+			So(k, ShouldEqual, 90)
+			k, _, _ = m.Ceiling(95)
+			So(k, ShouldEqual, 210)
+			So(m.DeleteRange(95, 200), ShouldEqual, 0)
+			So(m.DeleteRange(300, 200), ShouldEqual, 0)
+			So(m.DeleteRange(-100, 100000), ShouldEqual, 989)
+			So(m.IsEmpty(), ShouldEqual, true)
+		})
+
+		Convey("DeleteBefore", func() {
+			So(m.DeleteBefore(5000), ShouldEqual, 500)
+			k, _ := m.Min()
+			So(k, ShouldEqual, 5000)
+			So(m.DeleteBefore(5000), ShouldEqual, 0)
+			So(m.Rank(6000), ShouldEqual, 100)
+		})
+
+		Convey("DeleteAfter", func() {
+			So(m.DeleteAfter(5000), ShouldEqual, 499)
+			k, _ := m.Max()
+			So(k, ShouldEqual, 5000)
+			So(m.DeleteAfter(-1), ShouldEqual, 501)
+			So(m.IsEmpty(), ShouldEqual, true)
+			So(m.DeleteAfter(-1), ShouldEqual, 0)
+		})
+
+		Convey("The map is still usable and snapshots are unchanged", func() {
+			s := m.Snapshot()
+			So(m.DeleteRange(100, 8000), ShouldEqual, 791)
+			for i := int64(0); i < 1000; i++ {
+				m.Put(i*10+5, i)
+			}
+			So(m.Len(), ShouldEqual, 1209)
+			So(m.CountRange(100, 8000), ShouldEqual, 790)
+			So(s.Len(), ShouldEqual, 1000)
+			So(s.CountRange(100, 8000), ShouldEqual, 791)
+		})
+	})
+}
+
+func BenchmarkNew_DeleteRange(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		k := i % rangeLenGeneric
+		m.DeleteRange(k, k+10)
+		for j := k; j <= k+10; j++ {
+			m.Put(j, j)
+		}
+	}
+}
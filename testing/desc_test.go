@@ -0,0 +1,75 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDescAndFunc(t *testing.T) {
+	Convey("Int map in DESC", t, func() {
+		m := orderedmap.NewIntDesc()
+		for _, k := range []int{3, math.MinInt, 7, 1, math.MaxInt} {
+			m.Put(k, k*2)
+		}
+		So(m.Keys(), ShouldResemble, []int{math.MaxInt, 7, 3, 1, math.MinInt})
+		k, _ := m.PopMin()
+		So(k, ShouldEqual, math.MaxInt)
+		k, _ = m.Max()
+		So(k, ShouldEqual, math.MinInt)
+		So(len(m.Range(7, 1)), ShouldEqual, 3)
+		So(len(m.Range(1, 7)), ShouldEqual, 0)
+		k, _, _ = m.Floor(5)
+		So(k, ShouldEqual, 7)
+	})
+
+	Convey("String map ordered by a comparator", t, func() {
+		m := orderedmap.NewStringFunc(func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		m.Put("b", 1)
+		m.Put("A", 2)
+		m.Put("C", 3)
+		So(m.Keys(), ShouldResemble, []string{"A", "b", "C"})
+	})
+
+	Convey("Priority queue on a concurrent Float64 map", t, func() {
+		m := orderedmap.NewSyncFloat64Desc()
+		m.Put(0.5, "low")
+		m.Put(9.5, "high")
+		m.Put(math.NaN(), "nan")
+		_, v := m.PopMin()
+		So(v, ShouldEqual, "high")
+		k, _ := m.Max()
+		So(math.IsNaN(k), ShouldEqual, true)
+
+		u := orderedmap.NewSyncUint8Func(orderedmap.Reverse(func(a, b uint8) int { return int(a) - int(b) }))
+		u.Put(1, nil)
+		u.Put(255, nil)
+		So(u.Keys(), ShouldResemble, []uint8{255, 1})
+	})
+
+	Convey("Bytes map in DESC keeps copying keys", t, func() {
+		m := orderedmap.NewBytesDesc()
+		buf := []byte("a")
+		m.Put(buf, 1)
+		buf[0] = 'b'
+		m.Put(buf, 2)
+		So(m.Keys(), ShouldResemble, [][]byte{[]byte("b"), []byte("a")})
+		d := orderedmap.NewDurationDesc()
+		d.Put(1, nil)
+		d.Put(2, nil)
+		k, _ := d.Min()
+		So(k, ShouldEqual, time.Duration(2))
+	})
+}
+
+func BenchmarkIntDesc_Put(b *testing.B) {
+	m := orderedmap.NewIntDesc()
+	for i := 0; i < b.N; i++ {
+		m.Put(i%rangeLenGeneric, i)
+	}
+}
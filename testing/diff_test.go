@@ -0,0 +1,87 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	Convey("Diff yields the differences in ASC", t, func() {
+		a := orderedmap.NewString()
+		a.Put("apple", 1)
+		a.Put("banana", 2)
+		a.Put("cherry", 3)
+		b := orderedmap.NewString()
+		b.Put("banana", 2)
+		b.Put("cherry", 30)
+		b.Put("date", 4)
+
+		got := slices.Collect(orderedmap.Diff(a, b, nil))
+		So(got, ShouldResemble, []orderedmap.DiffEntry[string, interface{}]{
+			{Kind: orderedmap.Removed, Key: "apple", Old: 1},
+			{Kind: orderedmap.Changed, Key: "cherry", Old: 3, New: 30},
+			{Kind: orderedmap.Added, Key: "date", New: 4},
+		})
+		So(got[0].Kind.String(), ShouldEqual, "Removed")
+
+		Convey("Custom equal", func() {
+			always := func(x, y interface{}) bool { return true }
+			So(len(slices.Collect(orderedmap.Diff(a, b, always))), ShouldEqual, 2)
+		})
+
+		Convey("ApplyDiff makes a copy of a equal to b", func() {
+			c := a.Snapshot().Clone()
+			c.ApplyDiff(orderedmap.Diff(a, b, nil))
+			So(c.RangeAll(), ShouldResemble, b.RangeAll())
+			So(a.Len(), ShouldEqual, 3)
+			So(slices.Collect(orderedmap.Diff(c, b, nil)), ShouldBeEmpty)
+		})
+
+		Convey("Stop early", func() {
+			n := 0
+			for range orderedmap.Diff(a, b, nil) {
+				n++
+				break
+			}
+			So(n, ShouldEqual, 1)
+		})
+	})
+
+	Convey("Diff between versions of a map", t, func() {
+		m := orderedmap.New[int, int]()
+		for i := 0; i < 10000; i++ {
+			m.Put(i, i)
+		}
+		old := m.Snapshot().Clone()
+		m.Put(5000, -1)
+		m.Delete(7)
+		m.Put(20000, 1)
+		got := slices.Collect(orderedmap.Diff(old, m, nil))
+		So(got, ShouldResemble, []orderedmap.DiffEntry[int, int]{
+			{Kind: orderedmap.Removed, Key: 7, Old: 7},
+			{Kind: orderedmap.Changed, Key: 5000, Old: 5000, New: -1},
+			{Kind: orderedmap.Added, Key: 20000, New: 1},
+		})
+
+		s := orderedmap.NewSync[int, int]()
+		s.ApplyDiff(orderedmap.Diff(orderedmap.New[int, int](), m, nil))
+		So(s.Len(), ShouldEqual, m.Len())
+	})
+}
+
+func BenchmarkNew_DiffVersions(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	old := m.Snapshot().Clone()
+	m.Put(rangeLenGeneric/2, -1)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for range orderedmap.Diff(old, m, nil) {
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestNewFloat64(t *testing.T) {
+	Convey("NewFloat64 orders NaN first and merges the zeros", t, func() {
+		m := orderedmap.NewFloat64()
+		m.Put(1.5, "a")
+		m.Put(math.NaN(), "nan")
+		m.Put(math.Inf(-1), "-inf")
+		m.Put(math.Inf(1), "inf")
+		m.Put(0.0, "+0")
+		m.Put(math.Copysign(0, -1), "-0")
+		m.Put(math.NaN(), "nan2")
+		So(m.Len(), ShouldEqual, 5)
+		keys := m.Keys()
+		So(math.IsNaN(keys[0]), ShouldEqual, true)
+		So(keys[1:], ShouldResemble, []float64{math.Inf(-1), 0, 1.5, math.Inf(1)})
+		v, ok := m.Get(math.NaN())
+		So(ok, ShouldEqual, true)
+		So(v, ShouldEqual, "nan2")
+		v, _ = m.Get(0)
+		So(v, ShouldEqual, "-0")
+		k, _, _ := m.Floor(-1)
+		So(k, ShouldEqual, math.Inf(-1))
+
+		m.Delete(math.NaN())
+		So(m.Len(), ShouldEqual, 4)
+	})
+
+	Convey("ZerosDistinct keeps -0 and +0 apart", t, func() {
+		m := orderedmap.NewFloat64Zeros(orderedmap.ZerosDistinct)
+		negZero := math.Copysign(0, -1)
+		m.Put(0.0, "+0")
+		m.Put(negZero, "-0")
+		m.Put(-1, "-1")
+		m.Put(math.NaN(), "nan")
+		So(m.Len(), ShouldEqual, 4)
+		v, _ := m.Get(negZero)
+		So(v, ShouldEqual, "-0")
+		v, _ = m.Get(0)
+		So(v, ShouldEqual, "+0")
+		k, _, _ := m.Lower(0)
+		So(math.Signbit(k), ShouldEqual, true)
+		So(m.Rank(0), ShouldEqual, 3)
+	})
+
+	Convey("Random floats keep the tree valid", t, func() {
+		m := orderedmap.NewFloat64Zeros(orderedmap.ZerosDistinct)
+		specials := []float64{math.NaN(), math.Inf(1), math.Inf(-1), 0, math.Copysign(0, -1)}
+		for i := 0; i < 2000; i++ {
+			x := rand.NormFloat64()
+			if i%10 == 0 {
+				x = specials[rand.Intn(len(specials))]
+			}
+			m.Put(x, i)
+			if i%3 == 0 {
+				m.Delete(x)
+			}
+		}
+		prev := math.Inf(-1)
+		n := 0
+		for k := range m.All() {
+			if math.IsNaN(k) {
+				So(n, ShouldEqual, 0)
+			} else {
+				So(k >= prev, ShouldEqual, true)
+				prev = k
+			}
+			n++
+		}
+		So(n, ShouldEqual, m.Len())
+	})
+}
+
+func TestNewFloat32(t *testing.T) {
+	Convey("NewFloat32", t, func() {
+		m := orderedmap.NewFloat32()
+		m.Put(float32(math.NaN()), 1)
+		m.Put(2.5, 2)
+		m.Put(-2.5, 3)
+		k, _ := m.Min()
+		So(k != k, ShouldEqual, true)
+		So(m.Keys()[1:], ShouldResemble, []float32{-2.5, 2.5})
+
+		z := orderedmap.NewFloat32Zeros(orderedmap.ZerosDistinct)
+		z.Put(0, 1)
+		z.Put(float32(math.Copysign(0, -1)), 2)
+		So(z.Len(), ShouldEqual, 2)
+		So(orderedmap.NewSyncFloat32().Len(), ShouldEqual, 0)
+	})
+}
+
+func BenchmarkFloat64_Put(b *testing.B) {
+	m := orderedmap.NewFloat64()
+	for i := 0; i < b.N; i++ {
+		m.Put(float64(i%rangeLenGeneric)/3, i)
+	}
+}
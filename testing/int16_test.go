@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapInt16_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt16_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt16)
+	sl := make([]int16, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int16(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkInt16_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt16()
@@ -276,6 +292,22 @@ func BenchmarkHashMapInt16_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt16_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt16)
+	sl := make([]int16, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int16(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkInt16_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt16()
@@ -308,6 +340,22 @@ func BenchmarkHashMapInt16_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt16_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt16)
+	sl := make([]int16, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int16(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkInt16_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt16()
@@ -360,3 +408,22 @@ func BenchmarkHashMapInt16_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyInt16_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt16)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenInt16; i++ {
+		key := int16(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyInt16 is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyInt16(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(int16), key2.(int16))
+}
@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapInt32_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt32_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt32)
+	sl := make([]int32, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int32(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkInt32_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt32()
@@ -276,6 +292,22 @@ func BenchmarkHashMapInt32_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt32_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt32)
+	sl := make([]int32, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int32(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkInt32_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt32()
@@ -308,6 +340,22 @@ func BenchmarkHashMapInt32_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt32_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt32)
+	sl := make([]int32, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int32(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkInt32_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt32()
@@ -360,3 +408,22 @@ func BenchmarkHashMapInt32_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyInt32_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt32)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenInt32; i++ {
+		key := int32(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyInt32 is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyInt32(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(int32), key2.(int32))
+}
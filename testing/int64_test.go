@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapInt64_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt64_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt64)
+	sl := make([]int64, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int64(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkInt64_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt64()
@@ -276,6 +292,22 @@ func BenchmarkHashMapInt64_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt64_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt64)
+	sl := make([]int64, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int64(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkInt64_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt64()
@@ -308,6 +340,22 @@ func BenchmarkHashMapInt64_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt64_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt64)
+	sl := make([]int64, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int64(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkInt64_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt64()
@@ -360,3 +408,22 @@ func BenchmarkHashMapInt64_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyInt64_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt64)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenInt64; i++ {
+		key := int64(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyInt64 is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyInt64(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(int64), key2.(int64))
+}
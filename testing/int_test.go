@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapInt_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt)
+	sl := make([]int, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkInt_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt()
@@ -276,6 +292,22 @@ func BenchmarkHashMapInt_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt)
+	sl := make([]int, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkInt_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt()
@@ -308,6 +340,22 @@ func BenchmarkHashMapInt_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyInt_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt)
+	sl := make([]int, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := int(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkInt_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewInt()
@@ -360,3 +408,22 @@ func BenchmarkHashMapInt_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyInt_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyInt)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenInt; i++ {
+		key := int(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyInt is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyInt(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(int), key2.(int))
+}
@@ -0,0 +1,138 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestIter(t *testing.T) {
+	Convey("Iterators over keys 0, 2, 4, ..., 98", t, func() {
+		m := orderedmap.New[int, int]()
+		for i := 0; i < 100; i += 2 {
+			m.Put(i, i*10)
+		}
+
+		Convey("All", func() {
+			i := 0
+			for k, v := range m.All() {
+				So(k, ShouldEqual, i)
+				So(v, ShouldEqual, i*10)
+				i += 2
+			}
+			So(i, ShouldEqual, 100)
+		})
+
+		Convey("Backward", func() {
+			i := 98
+			for k, v := range m.Backward() {
+				So(k, ShouldEqual, i)
+				So(v, ShouldEqual, i*10)
+				i -= 2
+			}
+			So(i, ShouldEqual, -2)
+		})
+
+		Convey("AscendFrom", func() {
+			var keys []int
+			for k := range m.AscendFrom(91) {
+				keys = append(keys, k)
+			}
+			So(keys, ShouldResemble, []int{92, 94, 96, 98})
+			keys = keys[:0]
+			for k := range m.AscendFrom(100) {
+				keys = append(keys, k)
+			}
+			So(len(keys), ShouldEqual, 0)
+		})
+
+		Convey("DescendFrom", func() {
+			var keys []int
+			for k := range m.DescendFrom(6) {
+				keys = append(keys, k)
+			}
+			So(keys, ShouldResemble, []int{6, 4, 2, 0})
+			keys = keys[:0]
+			for k := range m.DescendFrom(-1) {
+				keys = append(keys, k)
+			}
+			So(len(keys), ShouldEqual, 0)
+		})
+
+		Convey("Between", func() {
+			var keys []int
+			for k := range m.Between(10, 17) {
+				keys = append(keys, k)
+			}
+			So(keys, ShouldResemble, []int{10, 12, 14, 16})
+			keys = keys[:0]
+			for k := range m.Between(17, 10) {
+				keys = append(keys, k)
+			}
+			So(len(keys), ShouldEqual, 0)
+		})
+
+		Convey("Break", func() {
+			var keys []int
+			for k := range m.All() {
+				if k > 4 {
+					break
+				}
+				keys = append(keys, k)
+			}
+			So(keys, ShouldResemble, []int{0, 2, 4})
+			keys = keys[:0]
+			for k := range m.Between(20, 80) {
+				keys = append(keys, k)
+				if len(keys) == 2 {
+					break
+				}
+			}
+			So(keys, ShouldResemble, []int{20, 22})
+		})
+
+		Convey("EmptyMap", func() {
+			m := orderedmap.NewInt()
+			count := 0
+			for range m.All() {
+				count++
+			}
+			for range m.Backward() {
+				count++
+			}
+			So(count, ShouldEqual, 0)
+		})
+	})
+}
+
+func BenchmarkNew_All(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	b.ReportAllocs()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for range m.All() {
+		}
+	}
+}
+
+func BenchmarkNew_AscendFromBreak(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	b.ReportAllocs()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		n := 0
+		for range m.AscendFrom(i % rangeLenGeneric) {
+			if n++; n == 8 {
+				break
+			}
+		}
+	}
+}
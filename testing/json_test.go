@@ -0,0 +1,99 @@
+package orderedmap_test
+
+import (
+	"encoding/json"
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	Convey("MarshalJSON keeps the keys in ASC", t, func() {
+		Convey("Int keys", func() {
+			m := orderedmap.NewInt()
+			m.Put(10, "ten")
+			m.Put(-2, 2)
+			m.Put(3, []int{3})
+			b, err := json.Marshal(m)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"-2":2,"3":[3],"10":"ten"}`)
+
+			n := orderedmap.NewInt()
+			So(json.Unmarshal(b, n), ShouldBeNil)
+			So(n.Keys(), ShouldResemble, []int{-2, 3, 10})
+			v, _ := n.Get(10)
+			So(v, ShouldEqual, "ten")
+		})
+
+		Convey("String keys need escaping", func() {
+			m := orderedmap.New[string, int]()
+			m.Put("b\"", 2)
+			m.Put("a", 1)
+			b, err := json.Marshal(m)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"a":1,"b\"":2}`)
+		})
+
+		Convey("Empty map", func() {
+			b, err := json.Marshal(orderedmap.NewUint64())
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{}`)
+		})
+
+		Convey("Extreme numbers round-trip as keys", func() {
+			m := orderedmap.New[int64, bool]()
+			m.Put(math.MinInt64, true)
+			m.Put(math.MaxInt64, false)
+			b, _ := json.Marshal(m)
+			So(string(b), ShouldEqual, `{"-9223372036854775808":true,"9223372036854775807":false}`)
+			n := orderedmap.New[int64, bool]()
+			So(json.Unmarshal(b, n), ShouldBeNil)
+			So(n.Keys(), ShouldResemble, []int64{math.MinInt64, math.MaxInt64})
+		})
+
+		Convey("Struct fields are allocated by the decoder", func() {
+			type doc struct {
+				Prices *orderedmap.OrderedMap[uint16, float64] `json:"prices"`
+			}
+			var d doc
+			So(json.Unmarshal([]byte(`{"prices":{"7":0.5,"3":1.5}}`), &d), ShouldBeNil)
+			So(d.Prices.Keys(), ShouldResemble, []uint16{3, 7})
+			d.Prices.Put(5, 2)
+			b, _ := json.Marshal(d)
+			So(string(b), ShouldEqual, `{"prices":{"3":1.5,"5":2,"7":0.5}}`)
+		})
+
+		Convey("Invalid input", func() {
+			m := orderedmap.New[int8, int]()
+			So(json.Unmarshal([]byte(`{"300":1}`), m), ShouldNotBeNil)
+			So(json.Unmarshal([]byte(`[1]`), m), ShouldNotBeNil)
+			So(json.Unmarshal([]byte(`{"1":"x"}`), m), ShouldNotBeNil)
+		})
+	})
+
+	Convey("JSONPairs keeps the JSON type of the keys", t, func() {
+		m := orderedmap.New[float64, string]()
+		m.Put(0.1, "a")
+		m.Put(-1e300, "b")
+		b, err := json.Marshal(orderedmap.JSONPairs[float64, string]{M: m})
+		So(err, ShouldBeNil)
+		So(string(b), ShouldEqual, `[{"key":-1e+300,"value":"b"},{"key":0.1,"value":"a"}]`)
+
+		var p orderedmap.JSONPairs[float64, string]
+		So(json.Unmarshal(b, &p), ShouldBeNil)
+		So(p.M.Keys(), ShouldResemble, []float64{-1e300, 0.1})
+	})
+
+	Convey("Sync and Snapshot", t, func() {
+		m := orderedmap.NewSyncString()
+		So(json.Unmarshal([]byte(`{"b":1,"a":2}`), m), ShouldBeNil)
+		b, _ := json.Marshal(m)
+		So(string(b), ShouldEqual, `{"a":2,"b":1}`)
+
+		n := orderedmap.NewString()
+		n.Put("x", nil)
+		b, _ = json.Marshal(n.Snapshot())
+		So(string(b), ShouldEqual, `{"x":null}`)
+	})
+}
@@ -0,0 +1,126 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestSetAlgebra(t *testing.T) {
+	Convey("Union, Intersection, Difference and SymmetricDifference", t, func() {
+		a := orderedmap.NewString()
+		b := orderedmap.NewString()
+		a.Put("x", 1)
+		a.Put("y", 2)
+		b.Put("y", 20)
+		b.Put("z", 30)
+		sum := func(key string, x, y interface{}) interface{} {
+			return x.(int) + y.(int)
+		}
+
+		u := a.Union(b, sum)
+		So(u.RangeAll(), ShouldResemble, []orderedmap.StringKeyValue{{Key: "x", Value: 1}, {Key: "y", Value: 22}, {Key: "z", Value: 30}})
+		u = a.Union(b, nil)
+		v, _ := u.Get("y")
+		So(v, ShouldEqual, 20)
+
+		i := a.Intersection(b, sum)
+		So(i.RangeAll(), ShouldResemble, []orderedmap.StringKeyValue{{Key: "y", Value: 22}})
+		So(a.Difference(b).Keys(), ShouldResemble, []string{"x"})
+		So(b.Difference(a).Keys(), ShouldResemble, []string{"z"})
+		So(a.SymmetricDifference(b).Keys(), ShouldResemble, []string{"x", "z"})
+
+		So(a.Len(), ShouldEqual, 2)
+		So(b.Len(), ShouldEqual, 2)
+		u.Put("w", 0)
+		So(a.Len(), ShouldEqual, 2)
+	})
+
+	Convey("Small and large maps give the same result as the merge", t, func() {
+		for _, sizes := range [][2]int{{1000, 1000}, {1000, 5}, {5, 1000}, {0, 100}, {100, 0}} {
+			a, b := orderedmap.New[uint64, int](), orderedmap.New[uint64, int]()
+			ra, rb := map[uint64]int{}, map[uint64]int{}
+			for i := 0; i < sizes[0]; i++ {
+				k := uint64(rand.Intn(2000))
+				a.Put(k, i)
+				ra[k] = i
+			}
+			for i := 0; i < sizes[1]; i++ {
+				k := uint64(rand.Intn(2000))
+				b.Put(k, -i)
+				rb[k] = -i
+			}
+			union, inter, diff, sym := map[uint64]int{}, map[uint64]int{}, map[uint64]int{}, map[uint64]int{}
+			for k, v := range ra {
+				union[k] = v
+				if w, ok := rb[k]; ok {
+					union[k] = v - w
+					inter[k] = v - w
+				} else {
+					diff[k] = v
+					sym[k] = v
+				}
+			}
+			for k, v := range rb {
+				if _, ok := ra[k]; !ok {
+					union[k] = v
+					sym[k] = v
+				}
+			}
+			sub := func(key uint64, x, y int) int {
+				return x - y
+			}
+			for _, c := range []struct {
+				got  *orderedmap.OrderedMap[uint64, int]
+				want map[uint64]int
+			}{{a.Union(b, sub), union}, {a.Intersection(b, sub), inter}, {a.Difference(b), diff}, {a.SymmetricDifference(b), sym}} {
+				So(c.got.Len(), ShouldEqual, len(c.want))
+				for k, v := range c.got.All() {
+					So(v, ShouldEqual, c.want[k])
+				}
+			}
+			So(a.Len(), ShouldEqual, len(ra))
+			So(b.Len(), ShouldEqual, len(rb))
+		}
+	})
+
+	Convey("Sync maps", t, func() {
+		a, b := orderedmap.NewSync[int, int](), orderedmap.NewSync[int, int]()
+		a.Put(1, 1)
+		b.Put(2, 2)
+		So(a.Union(b, nil).Len(), ShouldEqual, 2)
+		So(a.Intersection(b, nil).Len(), ShouldEqual, 0)
+		So(a.Difference(b).Len(), ShouldEqual, 1)
+		So(a.SymmetricDifference(b).Len(), ShouldEqual, 2)
+	})
+
+	Convey("A map read by several goroutines at once", t, func() {
+		a, b := orderedmap.New[int, int](), orderedmap.New[int, int]()
+		for i := 0; i < 1000; i++ {
+			a.Put(i, i)
+		}
+		b.Put(5, -5)
+		b.Put(2000, 0)
+		s := orderedmap.NewIntSet()
+		s.Add(1)
+		var wg sync.WaitGroup
+		lens := make([]int, 8)
+		for g := range lens {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				lens[g] = a.Union(b, nil).Len() + a.Difference(b).Len() + a.SymmetricDifference(b).Len() + s.Clone().Len()
+			}()
+		}
+		wg.Wait()
+		for _, n := range lens {
+			So(n, ShouldEqual, 1001+999+1000+1)
+		}
+		u := a.Union(b, nil)
+		u.Put(1, -1)
+		v, _ := a.Get(1)
+		So(v, ShouldEqual, 1)
+	})
+}
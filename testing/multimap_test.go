@@ -0,0 +1,69 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestMultiMap(t *testing.T) {
+	Convey("MultiMap keeps every value of a key in insertion order", t, func() {
+		m := orderedmap.NewMultiMap[int64, string]()
+		m.Put(20, "b1")
+		m.Put(10, "a1")
+		m.Put(20, "b2")
+		m.Put(20, "b3")
+		m.Put(30, "c1")
+		So(m.Len(), ShouldEqual, 5)
+		So(m.KeyLen(), ShouldEqual, 3)
+		So(m.Count(20), ShouldEqual, 3)
+		So(m.Count(40), ShouldEqual, 0)
+		So(m.Contains(10), ShouldEqual, true)
+		So(m.GetAll(20), ShouldResemble, []string{"b1", "b2", "b3"})
+		So(m.GetAll(40), ShouldBeNil)
+		So(m.Keys(), ShouldResemble, []int64{10, 20, 30})
+
+		var all []string
+		for _, v := range m.All() {
+			all = append(all, v)
+		}
+		So(all, ShouldResemble, []string{"a1", "b1", "b2", "b3", "c1"})
+		all = all[:0]
+		for _, v := range m.Backward() {
+			all = append(all, v)
+		}
+		So(all, ShouldResemble, []string{"c1", "b3", "b2", "b1", "a1"})
+		So(m.Range(15, 25), ShouldResemble, []orderedmap.KeyValue[int64, string]{
+			{Key: 20, Value: "b1"}, {Key: 20, Value: "b2"}, {Key: 20, Value: "b3"},
+		})
+
+		Convey("GetAll returns a copy", func() {
+			m.GetAll(20)[0] = "x"
+			So(m.GetAll(20)[0], ShouldEqual, "b1")
+		})
+
+		Convey("DeleteOne deletes the first inserted value", func() {
+			v, ok := m.DeleteOne(20)
+			So(v, ShouldEqual, "b1")
+			So(ok, ShouldEqual, true)
+			m.Put(20, "b4")
+			So(m.GetAll(20), ShouldResemble, []string{"b2", "b3", "b4"})
+			v, _ = m.DeleteOne(10)
+			So(v, ShouldEqual, "a1")
+			So(m.Contains(10), ShouldEqual, false)
+			_, ok = m.DeleteOne(10)
+			So(ok, ShouldEqual, false)
+			So(m.Len(), ShouldEqual, 4)
+		})
+
+		Convey("DeleteAll", func() {
+			So(m.DeleteAll(20), ShouldEqual, 3)
+			So(m.DeleteAll(20), ShouldEqual, 0)
+			So(m.Len(), ShouldEqual, 2)
+			So(m.KeyLen(), ShouldEqual, 2)
+			m.DeleteAll(10)
+			m.DeleteAll(30)
+			So(m.IsEmpty(), ShouldEqual, true)
+		})
+	})
+}
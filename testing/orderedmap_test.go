@@ -0,0 +1,335 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const (
+	testCountGeneric int = 1 << 8
+	rangeLenGeneric      = 1 << 10
+)
+
+func TestNew(t *testing.T) {
+	Convey("New and Put and Len", t, func() {
+		m := orderedmap.New[int, string]()
+		hm := make(map[int]struct{}, testCountGeneric)
+		sl := make([]int, 0, testCountGeneric)
+		rand.Seed(time.Now().UnixNano())
+		for i := 0; i < testCountGeneric; {
+			key := int(rand.Int63())
+			if rand.Intn(2) == 0 {
+				key = -key
+			}
+			if _, ok := hm[key]; !ok {
+				hm[key] = struct{}{}
+				m.Put(key, strconv.Itoa(key))
+				sl = append(sl, key)
+				i++
+			}
+		}
+		So(m.Len(), ShouldEqual, len(hm))
+		sort.Ints(sl)
+
+		Convey("Get", func() {
+			for k := range hm {
+				value, ok := m.Get(k)
+				So(ok, ShouldEqual, true)
+				So(value, ShouldEqual, strconv.Itoa(k))
+			}
+		})
+
+		Convey("Put replaces value", func() {
+			m.Put(sl[0], "replaced")
+			value, _ := m.Get(sl[0])
+			So(value, ShouldEqual, "replaced")
+			So(m.Len(), ShouldEqual, len(hm))
+		})
+
+		Convey("Min and Max", func() {
+			key, value := m.Min()
+			So(key, ShouldEqual, sl[0])
+			So(value, ShouldEqual, strconv.Itoa(sl[0]))
+			key, value = m.Max()
+			So(key, ShouldEqual, sl[len(sl)-1])
+			So(value, ShouldEqual, strconv.Itoa(sl[len(sl)-1]))
+		})
+
+		Convey("Keys and Values", func() {
+			keys := m.Keys()
+			values := m.Values()
+			So(len(keys), ShouldEqual, len(sl))
+			So(len(values), ShouldEqual, len(sl))
+			for i := range keys {
+				So(keys[i], ShouldEqual, sl[i])
+				So(values[i], ShouldEqual, strconv.Itoa(sl[i]))
+			}
+		})
+
+		Convey("RangeAll and RangeAllDesc", func() {
+			pairs := m.RangeAll()
+			So(len(pairs), ShouldEqual, len(sl))
+			for i := range pairs {
+				So(pairs[i].Key, ShouldEqual, sl[i])
+				So(pairs[i].Value, ShouldEqual, strconv.Itoa(sl[i]))
+			}
+			pairs = m.RangeAllDesc()
+			So(len(pairs), ShouldEqual, len(sl))
+			for i := range pairs {
+				So(pairs[i].Key, ShouldEqual, sl[len(sl)-1-i])
+			}
+		})
+
+		Convey("Range and RangeDesc", func() {
+			pairs := m.Range(sl[3], sl[10])
+			So(len(pairs), ShouldEqual, 8)
+			for i := range pairs {
+				So(pairs[i].Key, ShouldEqual, sl[3+i])
+			}
+			pairs = m.RangeDesc(sl[3], sl[10])
+			So(len(pairs), ShouldEqual, 8)
+			for i := range pairs {
+				So(pairs[i].Key, ShouldEqual, sl[10-i])
+			}
+		})
+
+		Convey("RangeN and RangeDescN", func() {
+			pairs := m.RangeN(3, sl[4]+1)
+			So(len(pairs), ShouldEqual, 3)
+			for i := range pairs {
+				So(pairs[i].Key, ShouldEqual, sl[5+i])
+			}
+			pairs = m.RangeDescN(3, sl[4]-1)
+			So(len(pairs), ShouldEqual, 3)
+			for i := range pairs {
+				So(pairs[i].Key, ShouldEqual, sl[3-i])
+			}
+		})
+
+		Convey("PopMin and PopMax", func() {
+			k, v := m.PopMin()
+			So(k, ShouldEqual, sl[0])
+			So(v, ShouldEqual, strconv.Itoa(sl[0]))
+			k, v = m.PopMax()
+			So(k, ShouldEqual, sl[len(sl)-1])
+			So(v, ShouldEqual, strconv.Itoa(sl[len(sl)-1]))
+			So(m.Len(), ShouldEqual, len(sl)-2)
+		})
+
+		Convey("Delete", func() {
+			count := len(hm)
+			for key := range hm {
+				m.Delete(key)
+				count--
+				So(m.Len(), ShouldEqual, count)
+				_, ok := m.Get(key)
+				So(ok, ShouldEqual, false)
+			}
+			So(m.IsEmpty(), ShouldEqual, true)
+		})
+
+		Convey("EmptyMap", func() {
+			m := orderedmap.New[int, string]()
+			value, ok := m.Get(1)
+			So(ok, ShouldEqual, false)
+			So(value, ShouldEqual, "")
+			k, v := m.Min()
+			So(k, ShouldEqual, 0)
+			So(v, ShouldEqual, "")
+			k, v = m.PopMax()
+			So(k, ShouldEqual, 0)
+			So(v, ShouldEqual, "")
+			So(len(m.RangeAll()), ShouldEqual, 0)
+			So(len(m.Range(1, 10)), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestNewFunc(t *testing.T) {
+	Convey("NewFunc with a descending comparator", t, func() {
+		m := orderedmap.NewFunc[string, int](func(a, b string) int {
+			if a < b {
+				return 1
+			} else if a > b {
+				return -1
+			}
+			return 0
+		})
+		for i := 0; i < 10; i++ {
+			m.Put(strconv.Itoa(i), i)
+		}
+		So(m.Len(), ShouldEqual, 10)
+
+		Convey("Min is the largest key", func() {
+			k, v := m.Min()
+			So(k, ShouldEqual, "9")
+			So(v, ShouldEqual, 9)
+		})
+
+		Convey("RangeAll follows the comparator", func() {
+			pairs := m.RangeAll()
+			for i := range pairs {
+				So(pairs[i].Value, ShouldEqual, 9-i)
+			}
+		})
+
+		Convey("Range bounds follow the comparator", func() {
+			pairs := m.Range("7", "3")
+			So(len(pairs), ShouldEqual, 5)
+			So(pairs[0].Key, ShouldEqual, "7")
+			So(pairs[4].Key, ShouldEqual, "3")
+		})
+	})
+}
+
+func TestNeighbors(t *testing.T) {
+	Convey("Floor, Ceiling, Lower and Higher over keys 10, 20, 30", t, func() {
+		m := orderedmap.New[int, string]()
+		m.Put(10, "a")
+		m.Put(20, "b")
+		m.Put(30, "c")
+
+		Convey("Floor", func() {
+			k, v, ok := m.Floor(20)
+			So(k, ShouldEqual, 20)
+			So(v, ShouldEqual, "b")
+			So(ok, ShouldEqual, true)
+			k, _, ok = m.Floor(29)
+			So(k, ShouldEqual, 20)
+			So(ok, ShouldEqual, true)
+			k, v, ok = m.Floor(9)
+			So(k, ShouldEqual, 0)
+			So(v, ShouldEqual, "")
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Ceiling", func() {
+			k, _, ok := m.Ceiling(20)
+			So(k, ShouldEqual, 20)
+			So(ok, ShouldEqual, true)
+			k, _, ok = m.Ceiling(11)
+			So(k, ShouldEqual, 20)
+			So(ok, ShouldEqual, true)
+			_, _, ok = m.Ceiling(31)
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Lower", func() {
+			k, v, ok := m.Lower(20)
+			So(k, ShouldEqual, 10)
+			So(v, ShouldEqual, "a")
+			So(ok, ShouldEqual, true)
+			k, _, ok = m.Lower(31)
+			So(k, ShouldEqual, 30)
+			So(ok, ShouldEqual, true)
+			_, _, ok = m.Lower(10)
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Higher", func() {
+			k, v, ok := m.Higher(20)
+			So(k, ShouldEqual, 30)
+			So(v, ShouldEqual, "c")
+			So(ok, ShouldEqual, true)
+			k, _, ok = m.Higher(-5)
+			So(k, ShouldEqual, 10)
+			So(ok, ShouldEqual, true)
+			_, _, ok = m.Higher(30)
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Random keys against a sorted slice", func() {
+			m := orderedmap.NewInt64()
+			sl := make([]int64, 0, testCountGeneric)
+			hm := make(map[int64]struct{})
+			rand.Seed(time.Now().UnixNano())
+			for len(sl) < testCountGeneric {
+				key := rand.Int63n(1 << 20)
+				if _, ok := hm[key]; !ok {
+					hm[key] = struct{}{}
+					sl = append(sl, key)
+					m.Put(key, nil)
+				}
+			}
+			sort.Slice(sl, func(i, j int) bool {
+				return sl[i] < sl[j]
+			})
+			for i := 0; i < 100; i++ {
+				key := rand.Int63n(1 << 20)
+				idx := sort.Search(len(sl), func(i int) bool { return sl[i] >= key })
+				k, _, ok := m.Ceiling(key)
+				So(ok, ShouldEqual, idx < len(sl))
+				if ok {
+					So(k, ShouldEqual, sl[idx])
+				}
+				k, _, ok = m.Lower(key)
+				So(ok, ShouldEqual, idx > 0)
+				if ok {
+					So(k, ShouldEqual, sl[idx-1])
+				}
+			}
+		})
+	})
+}
+
+func TestTypedAliases(t *testing.T) {
+	Convey("Typed constructors keep returning interface{} values", t, func() {
+		m := orderedmap.NewInt64()
+		m.Put(1, nil)
+		m.Put(2, "2")
+		value, ok := m.Get(1)
+		So(ok, ShouldEqual, true)
+		So(value, ShouldEqual, nil)
+		var kv orderedmap.Int64KeyValue = m.RangeAll()[1]
+		So(kv.Key, ShouldEqual, int64(2))
+		So(kv.Value, ShouldEqual, "2")
+	})
+}
+
+func BenchmarkNew_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	sl := make([]int, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		sl = append(sl, rand.Int())
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], i)
+	}
+}
+
+func BenchmarkNew_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	sl := make([]int, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := rand.Int()
+		sl = append(sl, key)
+		m.Put(key, i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
+func BenchmarkNew_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(rand.Int(), i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
@@ -0,0 +1,131 @@
+package orderedmap_test
+
+import (
+	"fmt"
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestPrefix(t *testing.T) {
+	Convey("Prefix queries on String map", t, func() {
+		m := orderedmap.NewString()
+		for _, k := range []string{"", "/", "/usr", "/usr/bin", "/usr/bin/go", "/usr/lib", "/usr0", "/var",
+			"日本", "日本語", "日本語/文", "日曜", "\xff", "\xff\x00"} {
+			m.Put(k, len(k))
+		}
+
+		Convey("PrefixRange", func() {
+			var keys []string
+			for _, kv := range orderedmap.PrefixRange(m, "/usr/") {
+				keys = append(keys, kv.Key)
+			}
+			So(keys, ShouldResemble, []string{"/usr/bin", "/usr/bin/go", "/usr/lib"})
+			keys = keys[:0]
+			for _, kv := range orderedmap.PrefixRange(m, "日本") {
+				keys = append(keys, kv.Key)
+			}
+			So(keys, ShouldResemble, []string{"日本", "日本語", "日本語/文"})
+			So(len(orderedmap.PrefixRange(m, "\xff")), ShouldEqual, 2)
+			So(len(orderedmap.PrefixRange(m, "")), ShouldEqual, m.Len())
+			So(orderedmap.PrefixRange(m, "/x"), ShouldBeNil)
+		})
+
+		Convey("PrefixCount", func() {
+			So(orderedmap.PrefixCount(m, "/usr"), ShouldEqual, 5)
+			So(orderedmap.PrefixCount(m, "/usr/"), ShouldEqual, 3)
+			So(orderedmap.PrefixCount(m, "日"), ShouldEqual, 4)
+			So(orderedmap.PrefixCount(m, "日本語"), ShouldEqual, 2)
+			So(orderedmap.PrefixCount(m, "\xff"), ShouldEqual, 2)
+			So(orderedmap.PrefixCount(m, ""), ShouldEqual, m.Len())
+			So(orderedmap.PrefixCount(m, "/a"), ShouldEqual, 0)
+			So(orderedmap.PrefixCount(m, "~"), ShouldEqual, 0)
+		})
+
+		Convey("DeletePrefix", func() {
+			So(orderedmap.DeletePrefix(m, "/usr/"), ShouldEqual, 3)
+			So(m.Keys()[:5], ShouldResemble, []string{"", "/", "/usr", "/usr0", "/var"})
+			So(orderedmap.DeletePrefix(m, "/usr/"), ShouldEqual, 0)
+			So(orderedmap.DeletePrefix(m, "日本"), ShouldEqual, 3)
+			So(orderedmap.PrefixCount(m, "日"), ShouldEqual, 1)
+			So(orderedmap.DeletePrefix(m, ""), ShouldEqual, 8)
+			So(m.IsEmpty(), ShouldEqual, true)
+		})
+
+		Convey("LongestPrefixOf", func() {
+			k, v, ok := orderedmap.LongestPrefixOf(m, "/usr/bin/gofmt")
+			So(ok, ShouldEqual, true)
+			So(k, ShouldEqual, "/usr/bin/go")
+			So(v, ShouldEqual, len(k))
+			k, _, _ = orderedmap.LongestPrefixOf(m, "/usr/local/bin")
+			So(k, ShouldEqual, "/usr")
+			k, _, _ = orderedmap.LongestPrefixOf(m, "/usr/lib")
+			So(k, ShouldEqual, "/usr/lib")
+			k, _, _ = orderedmap.LongestPrefixOf(m, "/tmp")
+			So(k, ShouldEqual, "/")
+			k, _, _ = orderedmap.LongestPrefixOf(m, "日本語学")
+			So(k, ShouldEqual, "日本語")
+			k, _, _ = orderedmap.LongestPrefixOf(m, "日本人")
+			So(k, ShouldEqual, "日本")
+			k, _, ok = orderedmap.LongestPrefixOf(m, "etc")
+			So(ok, ShouldEqual, true)
+			So(k, ShouldEqual, "")
+
+			m.Delete("")
+			_, _, ok = orderedmap.LongestPrefixOf(m, "etc")
+			So(ok, ShouldEqual, false)
+		})
+	})
+
+	Convey("Prefix queries on a String map in DESC", t, func() {
+		m := orderedmap.NewStringDesc()
+		for _, k := range []string{"/", "/usr", "/usr/bin", "/usr/lib", "/usr0", "/var"} {
+			m.Put(k, nil)
+		}
+		var keys []string
+		for _, kv := range orderedmap.PrefixRange(m, "/usr/") {
+			keys = append(keys, kv.Key)
+		}
+		So(keys, ShouldResemble, []string{"/usr/lib", "/usr/bin"})
+		So(orderedmap.PrefixCount(m, "/usr"), ShouldEqual, 4)
+		k, _, ok := orderedmap.LongestPrefixOf(m, "/usr/local")
+		So(ok, ShouldEqual, true)
+		So(k, ShouldEqual, "/usr")
+		So(orderedmap.DeletePrefix(m, "/usr/"), ShouldEqual, 2)
+		So(m.Keys(), ShouldResemble, []string{"/var", "/usr0", "/usr", "/"})
+	})
+
+	Convey("Prefix queries agree with strings.HasPrefix", t, func() {
+		m := orderedmap.NewString()
+		for i := 0; i < 500; i++ {
+			m.Put(fmt.Sprintf("%x", i*7919%4096), i)
+		}
+		for _, p := range []string{"", "1", "a", "ab", "f0", "fff", "g"} {
+			var want []string
+			for _, k := range m.Keys() {
+				if strings.HasPrefix(k, p) {
+					want = append(want, k)
+				}
+			}
+			var got []string
+			for _, kv := range orderedmap.PrefixRange(m, p) {
+				got = append(got, kv.Key)
+			}
+			So(got, ShouldResemble, want)
+			So(orderedmap.PrefixCount(m, p), ShouldEqual, len(want))
+		}
+	})
+}
+
+func BenchmarkPrefixCount(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewString()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(fmt.Sprintf("%08d", i), i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = orderedmap.PrefixCount(m, fmt.Sprintf("%06d", i%(rangeLenGeneric/100)))
+	}
+}
@@ -0,0 +1,114 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestRank(t *testing.T) {
+	Convey("Rank, Select, CountRange and Percentile over random keys", t, func() {
+		m := orderedmap.NewUint32()
+		hm := make(map[uint32]struct{}, testCountGeneric)
+		sl := make([]uint32, 0, testCountGeneric)
+		rand.Seed(time.Now().UnixNano())
+		for len(sl) < testCountGeneric {
+			key := uint32(rand.Int31n(1 << 16))
+			if _, ok := hm[key]; !ok {
+				hm[key] = struct{}{}
+				m.Put(key, key<<1)
+				sl = append(sl, key)
+			}
+		}
+		sort.Slice(sl, func(i, j int) bool {
+			return sl[i] < sl[j]
+		})
+
+		Convey("Rank", func() {
+			for i := range sl {
+				So(m.Rank(sl[i]), ShouldEqual, i)
+				So(m.Rank(sl[i]+1), ShouldEqual, sort.Search(len(sl), func(j int) bool { return sl[j] >= sl[i]+1 }))
+			}
+			So(m.Rank(0), ShouldEqual, sort.Search(len(sl), func(j int) bool { return sl[j] >= 0 }))
+			So(m.Rank(1<<16), ShouldEqual, len(sl))
+		})
+
+		Convey("Select", func() {
+			for i := range sl {
+				k, v, ok := m.Select(i)
+				So(ok, ShouldEqual, true)
+				So(k, ShouldEqual, sl[i])
+				So(v, ShouldEqual, sl[i]<<1)
+			}
+			_, _, ok := m.Select(-1)
+			So(ok, ShouldEqual, false)
+			_, _, ok = m.Select(len(sl))
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("CountRange", func() {
+			for i := 0; i < 100; i++ {
+				a, b := rand.Intn(len(sl)), rand.Intn(len(sl))
+				if a > b {
+					a, b = b, a
+				}
+				So(m.CountRange(sl[a], sl[b]), ShouldEqual, b-a+1)
+				So(m.CountRange(sl[a], sl[b]), ShouldEqual, len(m.Range(sl[a], sl[b])))
+				if a != b {
+					So(m.CountRange(sl[b], sl[a]), ShouldEqual, 0)
+				}
+			}
+		})
+
+		Convey("Rank after Delete", func() {
+			for i := 0; i < 10; i++ {
+				m.PopMin()
+			}
+			m.Delete(sl[20])
+			So(m.Rank(sl[10]), ShouldEqual, 0)
+			So(m.Rank(sl[21]), ShouldEqual, 10)
+			k, _, _ := m.Select(10)
+			So(k, ShouldEqual, sl[21])
+		})
+
+		Convey("Percentile", func() {
+			k, _, ok := m.Percentile(0)
+			So(ok, ShouldEqual, true)
+			So(k, ShouldEqual, sl[0])
+			k, _, _ = m.Percentile(50)
+			So(k, ShouldEqual, sl[len(sl)/2-1])
+			k, _, _ = m.Percentile(100)
+			So(k, ShouldEqual, sl[len(sl)-1])
+			_, _, ok = m.Percentile(101)
+			So(ok, ShouldEqual, false)
+			_, _, ok = m.Percentile(-1)
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("EmptyMap", func() {
+			m := orderedmap.NewString()
+			So(m.Rank("a"), ShouldEqual, 0)
+			So(m.CountRange("a", "z"), ShouldEqual, 0)
+			_, _, ok := m.Select(0)
+			So(ok, ShouldEqual, false)
+			_, _, ok = m.Percentile(50)
+			So(ok, ShouldEqual, false)
+		})
+	})
+}
+
+func BenchmarkNew_Select(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(rand.Int(), i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = m.Select(i % rangeLenGeneric)
+	}
+}
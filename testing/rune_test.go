@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapRune_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyRune_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyRune)
+	sl := make([]rune, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := rune(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkRune_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewRune()
@@ -276,6 +292,22 @@ func BenchmarkHashMapRune_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyRune_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyRune)
+	sl := make([]rune, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := rune(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkRune_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewRune()
@@ -308,6 +340,22 @@ func BenchmarkHashMapRune_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyRune_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyRune)
+	sl := make([]rune, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := rune(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkRune_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewRune()
@@ -360,3 +408,22 @@ func BenchmarkHashMapRune_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyRune_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyRune)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenRune; i++ {
+		key := rune(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyRune is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyRune(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(rune), key2.(rune))
+}
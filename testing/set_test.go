@@ -0,0 +1,90 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	Convey("IntSet", t, func() {
+		s := orderedmap.NewIntSet()
+		So(s.Add(5), ShouldEqual, true)
+		So(s.Add(1), ShouldEqual, true)
+		So(s.Add(9), ShouldEqual, true)
+		So(s.Add(5), ShouldEqual, false)
+		So(s.Len(), ShouldEqual, 3)
+		So(s.Contains(5), ShouldEqual, true)
+		So(s.Contains(4), ShouldEqual, false)
+		So(s.Keys(), ShouldResemble, []int{1, 5, 9})
+
+		k, ok := s.Min()
+		So(k, ShouldEqual, 1)
+		So(ok, ShouldEqual, true)
+		k, _ = s.Max()
+		So(k, ShouldEqual, 9)
+		k, _ = s.Floor(6)
+		So(k, ShouldEqual, 5)
+		k, _ = s.Ceiling(6)
+		So(k, ShouldEqual, 9)
+		k, _ = s.Lower(5)
+		So(k, ShouldEqual, 1)
+		_, ok = s.Higher(9)
+		So(ok, ShouldEqual, false)
+		So(s.Range(2, 9), ShouldResemble, []int{5, 9})
+		So(slices.Collect(s.Backward()), ShouldResemble, []int{9, 5, 1})
+		So(slices.Collect(s.AscendFrom(2)), ShouldResemble, []int{5, 9})
+		So(slices.Collect(s.DescendFrom(8)), ShouldResemble, []int{5, 1})
+		So(slices.Collect(s.Between(1, 5)), ShouldResemble, []int{1, 5})
+		So(s.Rank(9), ShouldEqual, 2)
+		k, _ = s.Select(1)
+		So(k, ShouldEqual, 5)
+
+		So(s.Remove(5), ShouldEqual, true)
+		So(s.Remove(5), ShouldEqual, false)
+		So(slices.Collect(s.All()), ShouldResemble, []int{1, 9})
+
+		e := orderedmap.NewIntSet()
+		_, ok = e.Min()
+		So(ok, ShouldEqual, false)
+		So(e.IsEmpty(), ShouldEqual, true)
+	})
+
+	Convey("Set algebra", t, func() {
+		a, b := orderedmap.NewStringSet(), orderedmap.NewStringSet()
+		for _, k := range []string{"a", "b", "c"} {
+			a.Add(k)
+		}
+		for _, k := range []string{"b", "c", "d"} {
+			b.Add(k)
+		}
+		So(a.Union(b).Keys(), ShouldResemble, []string{"a", "b", "c", "d"})
+		So(a.Intersection(b).Keys(), ShouldResemble, []string{"b", "c"})
+		So(a.Difference(b).Keys(), ShouldResemble, []string{"a"})
+		So(a.SymmetricDifference(b).Keys(), ShouldResemble, []string{"a", "d"})
+
+		c := a.Clone()
+		c.Add("z")
+		So(a.Len(), ShouldEqual, 3)
+		So(c.Len(), ShouldEqual, 4)
+	})
+
+	Convey("Custom order", t, func() {
+		s := orderedmap.NewSetFunc[string](func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		s.Add("b")
+		s.Add("A")
+		So(s.Add("a"), ShouldEqual, false)
+		So(s.Keys(), ShouldResemble, []string{"A", "b"})
+	})
+}
+
+func BenchmarkSet_Add(b *testing.B) {
+	s := orderedmap.NewSet[int]()
+	for i := 0; i < b.N; i++ {
+		s.Add(i % rangeLenGeneric)
+	}
+}
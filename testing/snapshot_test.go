@@ -0,0 +1,137 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSnapshot(t *testing.T) {
+	Convey("Snapshot keeps the version at the time it was taken", t, func() {
+		m := orderedmap.NewInt()
+		for i := 0; i < 100; i++ {
+			m.Put(i, i)
+		}
+		s := m.Snapshot()
+		before := m.RangeAll()
+
+		Convey("Modifying the map", func() {
+			for i := 0; i < 100; i += 2 {
+				m.Delete(i)
+			}
+			for i := 100; i < 200; i++ {
+				m.Put(i, i)
+			}
+			m.Put(1, "changed")
+			m.PopMax()
+			So(m.Len(), ShouldEqual, 149)
+			So(s.Len(), ShouldEqual, 100)
+			So(s.RangeAll(), ShouldResemble, before)
+			v, ok := s.Get(1)
+			So(ok, ShouldEqual, true)
+			So(v, ShouldEqual, 1)
+			v, _ = m.Get(1)
+			So(v, ShouldEqual, "changed")
+		})
+
+		Convey("Query methods", func() {
+			m.Delete(50)
+			k, _ := s.Min()
+			So(k, ShouldEqual, 0)
+			k, _ = s.Max()
+			So(k, ShouldEqual, 99)
+			So(len(s.Range(45, 55)), ShouldEqual, 11)
+			So(len(s.RangeN(3, 50)), ShouldEqual, 3)
+			So(s.RangeN(3, 50)[0].Key, ShouldEqual, 50)
+			So(s.CountRange(45, 55), ShouldEqual, 11)
+			k, _, _ = s.Floor(50)
+			So(k, ShouldEqual, 50)
+		})
+
+		Convey("Cursor modifications", func() {
+			c := m.Cursor()
+			for ok := c.First(); ok; {
+				if c.Key()%3 == 0 {
+					ok = c.Delete()
+				} else {
+					c.SetValue(0)
+					ok = c.Next()
+				}
+			}
+			So(m.Len(), ShouldEqual, 66)
+			So(s.RangeAll(), ShouldResemble, before)
+		})
+
+		Convey("Clone", func() {
+			m.Put(1000, 1000)
+			c := s.Clone()
+			c.Delete(0)
+			So(c.Len(), ShouldEqual, 99)
+			So(m.Len(), ShouldEqual, 101)
+			So(s.Len(), ShouldEqual, 100)
+			_, ok := c.Get(1000)
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Many versions", func() {
+			versions := []*orderedmap.Snapshot[int, interface{}]{s}
+			for i := 0; i < 10; i++ {
+				m.Delete(i)
+				versions = append(versions, m.Snapshot())
+			}
+			for i, v := range versions {
+				So(v.Len(), ShouldEqual, 100-i)
+				k, _ := v.Min()
+				So(k, ShouldEqual, i)
+			}
+		})
+	})
+
+	Convey("Readers on snapshots while the owner writes", t, func() {
+		m := orderedmap.New[int, int]()
+		for i := 0; i < 1000; i++ {
+			m.Put(i, i)
+		}
+		rand.Seed(time.Now().UnixNano())
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			s := m.Snapshot()
+			want := 0
+			for _, v := range m.All() {
+				want += v
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sum := 0
+				for _, v := range s.All() {
+					sum += v
+				}
+				if sum != want {
+					panic("snapshot changed")
+				}
+			}()
+			for i := 0; i < 1000; i++ {
+				m.Put(rand.Intn(1000), -1)
+			}
+		}
+		wg.Wait()
+		So(m.Len(), ShouldEqual, 1000)
+	})
+}
+
+func BenchmarkNew_PutAfterSnapshot(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.New[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Snapshot()
+		m.Put(i%rangeLenGeneric, i)
+	}
+}
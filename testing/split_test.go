@@ -0,0 +1,104 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestSplitJoin(t *testing.T) {
+	Convey("Split cuts the map at a key", t, func() {
+		m := orderedmap.NewUint32()
+		for i := uint32(0); i < 1000; i++ {
+			m.Put(i, i)
+		}
+		left, right := m.Split(300)
+		So(left.Len(), ShouldEqual, 300)
+		So(right.Len(), ShouldEqual, 700)
+		k, _ := left.Max()
+		So(k, ShouldEqual, 299)
+		k, _ = right.Min()
+		So(k, ShouldEqual, 300)
+		So(m.Len(), ShouldEqual, 1000)
+
+		Convey("The maps are independent", func() {
+			left.Put(5000, 0)
+			right.Delete(300)
+			m.Delete(0)
+			So(left.Len(), ShouldEqual, 301)
+			So(right.Len(), ShouldEqual, 699)
+			So(m.Len(), ShouldEqual, 999)
+			_, ok := m.Get(5000)
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Join puts them together again", func() {
+			So(right.Join(left), ShouldBeNil)
+			So(right.RangeAll(), ShouldResemble, m.RangeAll())
+			So(left.Len(), ShouldEqual, 300)
+			So(right.Rank(500), ShouldEqual, 500)
+		})
+
+		Convey("Join rejects overlapping key ranges", func() {
+			left.Put(400, 0)
+			So(left.Join(right), ShouldEqual, orderedmap.ErrOverlap)
+			So(left.Len(), ShouldEqual, 301)
+			So(left.Join(orderedmap.NewUint32()), ShouldBeNil)
+			e := orderedmap.NewUint32()
+			So(e.Join(right), ShouldBeNil)
+			So(e.Len(), ShouldEqual, 700)
+		})
+
+		Convey("Split at the ends", func() {
+			l, r := m.Split(0)
+			So(l.IsEmpty(), ShouldEqual, true)
+			So(r.Len(), ShouldEqual, 1000)
+			l, r = m.Split(1000)
+			So(l.Len(), ShouldEqual, 1000)
+			So(r.IsEmpty(), ShouldEqual, true)
+		})
+	})
+
+	Convey("Writing the joined map does not change other", t, func() {
+		for _, after := range []bool{true, false} {
+			a := orderedmap.New[int, string]()
+			b := orderedmap.New[int, string]()
+			for i := 0; i < 10; i++ {
+				a.Put(i, "a")
+				b.Put(i+20, "b")
+			}
+			want := b.RangeAll()
+			if after {
+				So(a.Join(b), ShouldBeNil)
+			} else {
+				e := orderedmap.New[int, string]()
+				So(e.Join(b), ShouldBeNil)
+				a = e
+			}
+			a.Put(25, "x")
+			for i := 20; i < 28; i++ {
+				a.Delete(i)
+			}
+			a.Put(30, "y")
+			So(b.RangeAll(), ShouldResemble, want)
+			So(b.Len(), ShouldEqual, 10)
+			v, _ := b.Get(25)
+			So(v, ShouldEqual, "b")
+		}
+	})
+
+	Convey("Sync maps join each other without deadlock", t, func() {
+		a := orderedmap.NewSync[int, int]()
+		b := orderedmap.NewSync[int, int]()
+		a.Put(1, 1)
+		b.Put(2, 2)
+		done := make(chan error, 2)
+		go func() { done <- a.Join(b) }()
+		go func() { done <- b.Join(a) }()
+		<-done
+		<-done
+		So(a.Len()+b.Len(), ShouldBeGreaterThanOrEqualTo, 3)
+		l, r := a.Split(2)
+		So(l.Len()+r.Len(), ShouldEqual, a.Len())
+	})
+}
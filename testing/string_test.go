@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -234,6 +235,21 @@ func BenchmarkHashMapString_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyString_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyString)
+	sl := make([]string, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := strconv.Itoa(int(rand.Int31n(0x40000000)))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkString_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewString()
@@ -266,6 +282,22 @@ func BenchmarkHashMapString_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyString_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyString)
+	sl := make([]string, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := strconv.Itoa(int(rand.Int31n(0x40000000)))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkString_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewString()
@@ -298,6 +330,22 @@ func BenchmarkHashMapString_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyString_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyString)
+	sl := make([]string, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := strconv.Itoa(int(rand.Int31n(0x40000000)))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkString_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewString()
@@ -350,3 +398,22 @@ func BenchmarkHashMapString_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyString_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyString)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenString; i++ {
+		key := strconv.Itoa(int(rand.Int31n(0x40000000)))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyString is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyString(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(string), key2.(string))
+}
@@ -0,0 +1,123 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"sync"
+	"testing"
+)
+
+func TestSync(t *testing.T) {
+	Convey("NewSync and compound operations", t, func() {
+		m := orderedmap.NewSync[string, int]()
+		m.Put("a", 1)
+
+		Convey("GetOrPut", func() {
+			v, loaded := m.GetOrPut("a", 2)
+			So(v, ShouldEqual, 1)
+			So(loaded, ShouldEqual, true)
+			v, loaded = m.GetOrPut("b", 2)
+			So(v, ShouldEqual, 2)
+			So(loaded, ShouldEqual, false)
+			So(m.Len(), ShouldEqual, 2)
+		})
+
+		Convey("CompareAndSwap", func() {
+			So(m.CompareAndSwap("a", 2, 3), ShouldEqual, false)
+			So(m.CompareAndSwap("a", 1, 3), ShouldEqual, true)
+			So(m.CompareAndSwap("b", 0, 3), ShouldEqual, false)
+			v, _ := m.Get("a")
+			So(v, ShouldEqual, 3)
+		})
+
+		Convey("CompareAndDelete", func() {
+			So(m.CompareAndDelete("a", 2), ShouldEqual, false)
+			So(m.CompareAndDelete("a", 1), ShouldEqual, true)
+			So(m.IsEmpty(), ShouldEqual, true)
+		})
+
+		Convey("Update", func() {
+			inc := func(v int, ok bool) (int, bool) {
+				return v + 1, true
+			}
+			m.Update("a", inc)
+			m.Update("b", inc)
+			So(m.RangeAll(), ShouldResemble, []orderedmap.KeyValue[string, int]{{Key: "a", Value: 2}, {Key: "b", Value: 1}})
+			m.Update("a", func(v int, ok bool) (int, bool) {
+				return 0, false
+			})
+			_, ok := m.Get("a")
+			So(ok, ShouldEqual, false)
+		})
+
+		Convey("Iterating while writing", func() {
+			for i := 0; i < 10; i++ {
+				m.Put(string(rune('b'+i)), i)
+			}
+			count := 0
+			for k := range m.All() {
+				m.Delete(k)
+				m.Put(k+k, 0)
+				count++
+			}
+			So(count, ShouldEqual, 11)
+			So(m.Len(), ShouldEqual, 11)
+		})
+	})
+
+	Convey("Concurrent writers and readers", t, func() {
+		m := orderedmap.NewSyncInt()
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					m.Put(w*1000+i, i)
+					m.Update(-1, func(v interface{}, ok bool) (interface{}, bool) {
+						if !ok {
+							return 1, true
+						}
+						return v.(int) + 1, true
+					})
+				}
+			}(w)
+		}
+		for r := 0; r < 4; r++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					prev := -2
+					for k := range m.All() {
+						if k <= prev {
+							panic("not ordered")
+						}
+						prev = k
+					}
+					_ = m.Range(100, 200)
+				}
+			}()
+		}
+		wg.Wait()
+		So(m.Len(), ShouldEqual, 8001)
+		v, _ := m.Get(-1)
+		So(v, ShouldEqual, 8000)
+	})
+}
+
+func BenchmarkSync_GetParallel(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewSync[int, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(i, i)
+	}
+	b.StartTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			_, _ = m.Get(i % rangeLenGeneric)
+			i++
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package orderedmap_test
+
+import (
+	"encoding/json"
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"iter"
+	"testing"
+	"time"
+)
+
+func TestNewTime(t *testing.T) {
+	Convey("Time map", t, func() {
+		base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		m := orderedmap.NewTime()
+		for i := 0; i < 10; i++ {
+			m.Put(base.Add(time.Duration(i)*20*time.Minute), i)
+		}
+		So(m.Len(), ShouldEqual, 10)
+
+		Convey("The monotonic clock reading is stripped", func() {
+			now := time.Now()
+			m.Put(now, "now")
+			k, _ := m.Max()
+			So(k, ShouldEqual, now.Round(0))
+			v, ok := m.Get(now.Round(0))
+			So(ok, ShouldEqual, true)
+			So(v, ShouldEqual, "now")
+			v, _ = m.Get(now)
+			So(v, ShouldEqual, "now")
+		})
+
+		Convey("Window, Before and After", func() {
+			var got []interface{}
+			for _, v := range m.Window(base.Add(40*time.Minute), base.Add(100*time.Minute)) {
+				got = append(got, v)
+			}
+			So(got, ShouldResemble, []interface{}{2, 3, 4})
+			got = got[:0]
+			for _, v := range m.Before(base.Add(40 * time.Minute)) {
+				got = append(got, v)
+			}
+			So(got, ShouldResemble, []interface{}{0, 1})
+			got = got[:0]
+			for _, v := range m.After(base.Add(140 * time.Minute)) {
+				got = append(got, v)
+			}
+			So(got, ShouldResemble, []interface{}{8, 9})
+		})
+
+		Convey("Latest", func() {
+			latest := m.Latest(3)
+			So(len(latest), ShouldEqual, 3)
+			So(latest[0].Value, ShouldEqual, 9)
+			So(latest[2].Value, ShouldEqual, 7)
+			So(len(m.Latest(100)), ShouldEqual, 10)
+			So(len(m.Latest(-1)), ShouldEqual, 0)
+		})
+
+		Convey("Bucket", func() {
+			var starts []time.Time
+			var sizes []int
+			for start, kvs := range m.Bucket(time.Hour) {
+				starts = append(starts, start)
+				sizes = append(sizes, len(kvs))
+			}
+			So(sizes, ShouldResemble, []int{3, 3, 3, 1})
+			So(starts[1], ShouldEqual, base.Add(time.Hour))
+
+			m.DeleteRange(base.Add(time.Hour), base.Add(2*time.Hour))
+			sizes = sizes[:0]
+			for _, kvs := range m.Bucket(time.Hour) {
+				sizes = append(sizes, len(kvs))
+			}
+			So(sizes, ShouldResemble, []int{3, 2, 1})
+
+			So(func() { m.Bucket(0) }, ShouldPanic)
+			So(func() { m.Bucket(-time.Hour) }, ShouldPanic)
+		})
+
+		Convey("JSON round-trip", func() {
+			b, err := json.Marshal(m)
+			So(err, ShouldBeNil)
+			var n orderedmap.Time
+			So(json.Unmarshal(b, &n), ShouldBeNil)
+			So(n.Len(), ShouldEqual, 10)
+			k, _ := n.Min()
+			So(k.Equal(base), ShouldEqual, true)
+		})
+	})
+
+	Convey("Time map in DESC and in a custom order", t, func() {
+		base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		// Ordered by the minute of the hour, then by time.
+		byMinute := func(a, b time.Time) int {
+			if c := a.Minute() - b.Minute(); c != 0 {
+				return c
+			}
+			return orderedmap.CompareTime(a, b)
+		}
+		values := func(seq iter.Seq2[time.Time, interface{}]) []interface{} {
+			var res []interface{}
+			for _, v := range seq {
+				res = append(res, v)
+			}
+			return res
+		}
+		for _, m := range []*orderedmap.Time{orderedmap.NewTimeDesc(), orderedmap.NewTimeFunc(byMinute)} {
+			for i := 0; i < 10; i++ {
+				m.Put(base.Add(time.Duration(i)*20*time.Minute), i)
+			}
+			var want, got []interface{}
+			for _, v := range m.All() {
+				if i := v.(int); i >= 2 && i < 5 {
+					want = append(want, v)
+				}
+			}
+			got = values(m.Window(base.Add(40*time.Minute), base.Add(100*time.Minute)))
+			So(got, ShouldResemble, want)
+			So(len(values(m.Before(base.Add(40*time.Minute)))), ShouldEqual, 2)
+			So(len(values(m.After(base.Add(140*time.Minute)))), ShouldEqual, 2)
+
+			latest := m.Latest(3)
+			So(len(latest), ShouldEqual, 3)
+			So(latest[0].Value, ShouldEqual, 9)
+			So(latest[2].Value, ShouldEqual, 7)
+
+			var sizes []int
+			for start, kvs := range m.Bucket(time.Hour) {
+				sizes = append(sizes, len(kvs))
+				for _, kv := range kvs {
+					So(kv.Key.Truncate(time.Hour), ShouldEqual, start)
+				}
+			}
+			So(len(sizes), ShouldEqual, 4)
+		}
+		m := orderedmap.NewTimeDesc()
+		m.Put(base, 0)
+		m.Put(base.Add(time.Hour), 1)
+		k, _ := m.Min()
+		So(k, ShouldEqual, base.Add(time.Hour))
+		So(values(m.Window(base, base.Add(2*time.Hour))), ShouldResemble, []interface{}{1, 0})
+	})
+
+	Convey("Duration map", t, func() {
+		m := orderedmap.NewDuration()
+		m.Put(time.Second, "s")
+		m.Put(time.Millisecond, "ms")
+		So(m.Keys(), ShouldResemble, []time.Duration{time.Millisecond, time.Second})
+	})
+}
@@ -0,0 +1,102 @@
+package orderedmap_test
+
+import (
+	"github.com/shengmingzhu/orderedmap"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestPair(t *testing.T) {
+	key := func(a uint64, b int64) orderedmap.Pair2[uint64, int64] { return orderedmap.MakePair2(a, b) }
+
+	Convey("Pair2 map ordered by tenant and then by timestamp", t, func() {
+		m := orderedmap.NewPair2[uint64, int64, string]()
+		m.Put(key(2, 10), "b10")
+		m.Put(key(1, 30), "a30")
+		m.Put(key(1, -5), "a-5")
+		m.Put(key(2, math.MinInt64), "bmin")
+		m.Put(key(10, 1), "c1")
+		m.Put(key(1, 30), "a30'")
+		So(m.Len(), ShouldEqual, 5)
+		So(m.Keys(), ShouldResemble, []orderedmap.Pair2[uint64, int64]{key(1, -5), key(1, 30), key(2, math.MinInt64), key(2, 10), key(10, 1)})
+
+		Convey("All entries of a tenant", func() {
+			var values []string
+			for _, v := range orderedmap.Pair2First(m, 2) {
+				values = append(values, v)
+			}
+			So(values, ShouldResemble, []string{"bmin", "b10"})
+			values = values[:0]
+			for _, v := range orderedmap.Pair2First(m, 1) {
+				values = append(values, v)
+			}
+			So(values, ShouldResemble, []string{"a-5", "a30'"})
+			for range orderedmap.Pair2First(m, 3) {
+				t.Fatal("no entries of tenant 3")
+			}
+		})
+
+		Convey("Range inside a tenant", func() {
+			pairs := m.Range(key(1, 0), key(1, math.MaxInt64))
+			So(len(pairs), ShouldEqual, 1)
+			So(pairs[0].Value, ShouldEqual, "a30'")
+		})
+	})
+
+	Convey("Pair3 map with prefix queries", t, func() {
+		key3 := orderedmap.MakePair3[string, int, float64]
+		m := orderedmap.NewPair3[string, int, float64, int]()
+		m.Put(key3("eu", 2, 0.5), 1)
+		m.Put(key3("eu", 1, 3), 2)
+		m.Put(key3("us", 1, math.NaN()), 3)
+		m.Put(key3("eu", 2, -1), 4)
+		m.Put(key3("us", 1, math.Inf(-1)), 5)
+		m.Put(key3("us", 1, math.NaN()), 6)
+		So(m.Len(), ShouldEqual, 5)
+
+		var values []int
+		for _, v := range orderedmap.Pair3First(m, "eu") {
+			values = append(values, v)
+		}
+		So(values, ShouldResemble, []int{2, 4, 1})
+		values = values[:0]
+		for _, v := range orderedmap.Pair3FirstSecond(m, "us", 1) {
+			values = append(values, v)
+		}
+		So(values, ShouldResemble, []int{6, 5})
+		So(key3("a", 1, 1).Compare(key3("a", 1, 2)), ShouldEqual, -1)
+	})
+
+	Convey("BetweenFunc on a map of struct keys", t, func() {
+		type point struct{ x, y int }
+		m := orderedmap.NewFunc[point, int](func(a, b point) int {
+			if a.x != b.x {
+				return a.x - b.x
+			}
+			return a.y - b.y
+		})
+		for i := 0; i < 100; i++ {
+			m.Put(point{i % 10, i}, i)
+		}
+		n := 0
+		for k := range m.BetweenFunc(func(p point) int { return p.x - 3 }) {
+			So(k.x, ShouldEqual, 3)
+			n++
+		}
+		So(n, ShouldEqual, 10)
+	})
+}
+
+func BenchmarkPair2First(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewPair2[uint64, int64, int]()
+	for i := 0; i < rangeLenGeneric; i++ {
+		m.Put(orderedmap.MakePair2(uint64(i%100), int64(i)), i)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for range orderedmap.Pair2First(m, uint64(i%100)) {
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapUint32_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint32_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint32)
+	sl := make([]uint32, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1)
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkUint32_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint32()
@@ -276,6 +292,22 @@ func BenchmarkHashMapUint32_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint32_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint32)
+	sl := make([]uint32, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1)
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkUint32_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint32()
@@ -308,6 +340,22 @@ func BenchmarkHashMapUint32_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint32_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint32)
+	sl := make([]uint32, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1)
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkUint32_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint32()
@@ -360,3 +408,22 @@ func BenchmarkHashMapUint32_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyUint32_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint32)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenUint32; i++ {
+		key := uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyUint32 is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyUint32(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(uint32), key2.(uint32))
+}
@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapUint8_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint8_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint8)
+	sl := make([]uint8, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint8(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkUint8_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint8()
@@ -276,6 +292,22 @@ func BenchmarkHashMapUint8_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint8_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint8)
+	sl := make([]uint8, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint8(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkUint8_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint8()
@@ -308,6 +340,22 @@ func BenchmarkHashMapUint8_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint8_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint8)
+	sl := make([]uint8, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint8(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkUint8_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint8()
@@ -360,3 +408,22 @@ func BenchmarkHashMapUint8_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyUint8_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint8)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenUint8; i++ {
+		key := uint8(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyUint8 is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyUint8(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(uint8), key2.(uint8))
+}
@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapUint_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint)
+	sl := make([]uint, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkUint_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint()
@@ -276,6 +292,22 @@ func BenchmarkHashMapUint_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint)
+	sl := make([]uint, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkUint_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint()
@@ -308,6 +340,22 @@ func BenchmarkHashMapUint_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUint_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint)
+	sl := make([]uint, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uint(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkUint_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUint()
@@ -360,3 +408,22 @@ func BenchmarkHashMapUint_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyUint_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUint)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenUint; i++ {
+		key := uint(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyUint is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyUint(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(uint), key2.(uint))
+}
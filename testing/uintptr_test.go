@@ -1,6 +1,7 @@
 package orderedmap_test
 
 import (
+	"cmp"
 	"github.com/shengmingzhu/orderedmap"
 	. "github.com/smartystreets/goconvey/convey"
 	"math/rand"
@@ -244,6 +245,21 @@ func BenchmarkHashMapUintptr_Put(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUintptr_Put(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUintptr)
+	sl := make([]uintptr, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uintptr(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Put(sl[i], struct{}{})
+	}
+}
+
 func BenchmarkUintptr_Get(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUintptr()
@@ -276,6 +292,22 @@ func BenchmarkHashMapUintptr_Get(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUintptr_Get(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUintptr)
+	sl := make([]uintptr, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uintptr(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Get(sl[i])
+	}
+}
+
 func BenchmarkUintptr_Delete(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUintptr()
@@ -308,6 +340,22 @@ func BenchmarkHashMapUintptr_Delete(b *testing.B) {
 	}
 }
 
+func BenchmarkAnyUintptr_Delete(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUintptr)
+	sl := make([]uintptr, 0, b.N)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < b.N; i++ {
+		key := uintptr(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		sl = append(sl, key)
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		m.Delete(sl[i])
+	}
+}
+
 func BenchmarkUintptr_RangeAll(b *testing.B) {
 	b.StopTimer()
 	m := orderedmap.NewUintptr()
@@ -360,3 +408,22 @@ func BenchmarkHashMapUintptr_RangeAllAndSort(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkAnyUintptr_RangeAll(b *testing.B) {
+	b.StopTimer()
+	m := orderedmap.NewAny(cmpAnyUintptr)
+	rand.Seed(time.Now().UnixNano())
+	for i := 0; i < rangeLenUintptr; i++ {
+		key := uintptr(uint32(rand.Int31n(0x40000000)) & (0x40000000 - 1))
+		m.Put(key, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.RangeAll()
+	}
+}
+
+// cmpAnyUintptr is the boxed comparator the typed maps used before they owned their tree.
+func cmpAnyUintptr(key1, key2 interface{}) int {
+	return cmp.Compare(key1.(uintptr), key2.(uintptr))
+}
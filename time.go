@@ -0,0 +1,193 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"time"
+)
+
+// CompareTime compares the instants of a and b without the monotonic clock readings,
+// so the order does not depend on whether the times were read by time.Now.
+func CompareTime(a, b time.Time) int {
+	return a.Round(0).Compare(b.Round(0))
+}
+
+// Time is a map keyed by time.Time in time order. It has all the methods of OrderedMap,
+// Put strips the monotonic clock reading of the key, and the time window queries are added.
+// The queries select the keys by time and yield them in the order of the map, such as the latest first in NewTimeDesc.
+type Time struct {
+	OrderedMap[time.Time, interface{}]
+	order timeOrder
+}
+
+type TimeKeyValue = KeyValue[time.Time, interface{}]
+
+// timeOrder is how the comparator of a Time map orders the instants.
+type timeOrder int
+
+const (
+	timeAsc  timeOrder = iota
+	timeDesc           // reversed
+	timeFunc           // any order, the keys of a time window are not contiguous
+)
+
+// NewTime returns an empty map ordered by CompareTime.
+func NewTime() *Time {
+	return &Time{OrderedMap: OrderedMap[time.Time, interface{}]{t: tree[time.Time, interface{}]{cmp: CompareTime}}}
+}
+
+// NewTimeDesc returns an empty map ordered by CompareTime in DESC, the latest first.
+func NewTimeDesc() *Time {
+	return &Time{OrderedMap: OrderedMap[time.Time, interface{}]{t: tree[time.Time, interface{}]{cmp: Reverse(CompareTime)}}, order: timeDesc}
+}
+
+// NewTimeFunc returns an empty map ordered by cmp. The time window queries scan all the keys, as the keys of a window need not be contiguous in the order of cmp.
+func NewTimeFunc(cmp func(a, b time.Time) int) *Time {
+	return &Time{OrderedMap: OrderedMap[time.Time, interface{}]{t: tree[time.Time, interface{}]{cmp: cmp}}, order: timeFunc}
+}
+
+// Put stores the key-value, the monotonic clock reading of the key is stripped.
+// O(logN)
+func (m *Time) Put(key time.Time, value interface{}) {
+	m.OrderedMap.Put(key.Round(0), value)
+}
+
+// Window returns an iterator over the key-values in [start, end) in the order of the map.
+// O(logN) to start, O(1) amortized per key-value, or O(N) for NewTimeFunc
+func (m *Time) Window(start, end time.Time) iter.Seq2[time.Time, interface{}] {
+	return m.span(func(k time.Time) bool { return CompareTime(k, start) >= 0 }, func(k time.Time) bool { return CompareTime(k, end) < 0 })
+}
+
+// Before returns an iterator over the key-values before t in the order of the map.
+// O(logN) to start, O(1) amortized per key-value, or O(N) for NewTimeFunc
+func (m *Time) Before(t time.Time) iter.Seq2[time.Time, interface{}] {
+	return m.span(func(time.Time) bool { return true }, func(k time.Time) bool { return CompareTime(k, t) < 0 })
+}
+
+// After returns an iterator over the key-values after t in the order of the map.
+// O(logN) to start, O(1) amortized per key-value, or O(N) for NewTimeFunc
+func (m *Time) After(t time.Time) iter.Seq2[time.Time, interface{}] {
+	return m.span(func(k time.Time) bool { return CompareTime(k, t) > 0 }, func(time.Time) bool { return true })
+}
+
+// span returns an iterator over the key-values whose keys are from(k) and to(k) in the order of the map,
+// from is false then true in time, and to is true then false.
+func (m *Time) span(from, to func(k time.Time) bool) iter.Seq2[time.Time, interface{}] {
+	if m.order == timeFunc {
+		return func(yield func(time.Time, interface{}) bool) {
+			for k, v := range m.All() {
+				if from(k) && to(k) && !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+	// The earlier keys are before the span in ASC and after it in DESC.
+	earlier := -1
+	if m.order == timeDesc {
+		earlier = 1
+	}
+	return m.BetweenFunc(func(k time.Time) int {
+		if !from(k) {
+			return earlier
+		} else if !to(k) {
+			return -earlier
+		}
+		return 0
+	})
+}
+
+// Latest returns the n latest key-values, the latest first.
+// O(logN) + O(n), or O(NlogN) for NewTimeFunc
+func (m *Time) Latest(n int) []TimeKeyValue {
+	res := make([]TimeKeyValue, 0, min(max(n, 0), m.Len()))
+	switch m.order {
+	case timeAsc:
+		for k, v := range m.Backward() {
+			if len(res) == cap(res) {
+				break
+			}
+			res = append(res, TimeKeyValue{Key: k, Value: v})
+		}
+	case timeDesc:
+		for k, v := range m.All() {
+			if len(res) == cap(res) {
+				break
+			}
+			res = append(res, TimeKeyValue{Key: k, Value: v})
+		}
+	default:
+		all := m.RangeAll()
+		slices.SortFunc(all, func(a, b TimeKeyValue) int { return CompareTime(b.Key, a.Key) })
+		res = append(res, all[:cap(res)]...)
+	}
+	return res
+}
+
+// Bucket returns an iterator over the key-values grouped by the buckets of duration d in the order of the map.
+// The buckets are aligned by time.Truncate, and each is yielded with its start time, the empty ones are skipped.
+// For NewTimeFunc, the buckets are yielded in the order of their first keys once all the keys are grouped.
+// Bucket panics if d <= 0.
+// O(N)
+func (m *Time) Bucket(d time.Duration) iter.Seq2[time.Time, []TimeKeyValue] {
+	if d <= 0 {
+		panic("orderedmap: non-positive duration for Bucket")
+	}
+	if m.order == timeFunc {
+		return func(yield func(time.Time, []TimeKeyValue) bool) {
+			var starts []time.Time
+			var buckets [][]TimeKeyValue
+			index := make(map[time.Time]int) // by the start in UTC, the same for the same instant
+			for k, v := range m.All() {
+				s := k.Truncate(d)
+				i, ok := index[s.UTC()]
+				if !ok {
+					i = len(buckets)
+					index[s.UTC()] = i
+					starts = append(starts, s)
+					buckets = append(buckets, nil)
+				}
+				buckets[i] = append(buckets[i], TimeKeyValue{Key: k, Value: v})
+			}
+			for i, s := range starts {
+				if !yield(s, buckets[i]) {
+					return
+				}
+			}
+		}
+	}
+	return func(yield func(time.Time, []TimeKeyValue) bool) {
+		var start time.Time
+		var bucket []TimeKeyValue
+		for k, v := range m.All() {
+			if s := k.Truncate(d); len(bucket) == 0 || !s.Equal(start) {
+				if len(bucket) > 0 && !yield(start, bucket) {
+					return
+				}
+				start, bucket = s, nil
+			}
+			bucket = append(bucket, TimeKeyValue{Key: k, Value: v})
+		}
+		if len(bucket) > 0 {
+			yield(start, bucket)
+		}
+	}
+}
+
+// Duration is a map keyed by time.Duration.
+type Duration = OrderedMap[time.Duration, interface{}]
+
+type DurationKeyValue = KeyValue[time.Duration, interface{}]
+
+func NewDuration() *Duration {
+	return New[time.Duration, interface{}]()
+}
+
+func NewDurationDesc() *Duration {
+	return NewFunc[time.Duration, interface{}](Reverse(cmp.Compare[time.Duration]))
+}
+
+func NewDurationFunc(cmp func(a, b time.Duration) int) *Duration {
+	return NewFunc[time.Duration, interface{}](cmp)
+}
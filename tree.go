@@ -0,0 +1,708 @@
+package orderedmap
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+	"sync/atomic"
+)
+
+// maxHeight bounds the height of a red-black tree with at most 1<<63 nodes, 2*log2(N+1).
+const maxHeight = 128
+
+type node[K, V any] struct {
+	left, right *node[K, V]
+	key         K
+	value       V
+	size        int    // number of nodes in the subtree rooted at this node
+	gen         uint64 // the generation of the tree which owns the node, see tree.mut
+	red         bool
+}
+
+// tree is a red-black tree storing K and V in the nodes directly.
+// Nodes have no parent pointer, the fixups after Put and Delete walk back along the search path.
+//
+// Nodes may be shared with snapshots. A tree only modifies the nodes of its own generation,
+// the other nodes are copied first, so the snapshots never change.
+type tree[K, V any] struct {
+	root     *node[K, V]
+	cmp      func(a, b K) int
+	cloneKey func(key K) K // if not nil, put stores a copy of a new key made by it
+	// if not nil, the keys with a prefix are not contiguous, but they are among the contiguous keys
+	// with prefixHead(prefix), see Natural
+	prefixHead func(prefix K) K
+	gen        uint64
+}
+
+var lastGen atomic.Uint64
+
+// like returns an empty tree which orders and stores the keys the same way as t.
+func (t *tree[K, V]) like() tree[K, V] {
+	return tree[K, V]{cmp: t.cmp, cloneKey: t.cloneKey, prefixHead: t.prefixHead}
+}
+
+// share gives t a new generation, so all nodes reachable from t.root are copied before being modified.
+// O(1)
+func (t *tree[K, V]) share() {
+	t.gen = lastGen.Add(1)
+}
+
+// mut returns n itself if t owns it, or a copy owned by t.
+func (t *tree[K, V]) mut(n *node[K, V]) *node[K, V] {
+	if n == nil || n.gen == t.gen {
+		return n
+	}
+	c := *n
+	c.gen = t.gen
+	return &c
+}
+
+// mutPath makes t own the nodes of p and n, relinking the copies, and returns n or its copy.
+func (t *tree[K, V]) mutPath(p *path[K, V], n *node[K, V]) *node[K, V] {
+	for i := 0; i < p.n; i++ {
+		if c := t.mut(p.nodes[i]); c != p.nodes[i] {
+			t.link(p, i, c)
+			p.nodes[i] = c
+		}
+	}
+	if c := t.mut(n); c != n {
+		t.link(p, p.n, c)
+		n = c
+	}
+	return n
+}
+
+// path records the nodes from the root down to the current node, and for each of them
+// whether the next node of the path is its right child.
+type path[K, V any] struct {
+	nodes [maxHeight]*node[K, V]
+	right [maxHeight]bool
+	n     int
+}
+
+func (p *path[K, V]) push(n *node[K, V], right bool) {
+	p.nodes[p.n] = n
+	p.right[p.n] = right
+	p.n++
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	r.size = n.size
+	n.size = size(n.left) + size(n.right) + 1
+	return r
+}
+
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	l.size = n.size
+	n.size = size(n.left) + size(n.right) + 1
+	return l
+}
+
+func (t *tree[K, V]) len() int {
+	return size(t.root)
+}
+
+// link makes n the child of p.nodes[i-1] at the recorded side, or the root if i is 0.
+func (t *tree[K, V]) link(p *path[K, V], i int, n *node[K, V]) {
+	if i == 0 {
+		t.root = n
+	} else if p.right[i-1] {
+		p.nodes[i-1].right = n
+	} else {
+		p.nodes[i-1].left = n
+	}
+}
+
+func (t *tree[K, V]) find(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c < 0 {
+			n = n.left
+		} else if c > 0 {
+			n = n.right
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+func (t *tree[K, V]) min() *node[K, V] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (t *tree[K, V]) max() *node[K, V] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// floor returns the node of the maximum key <= key, or nil.
+func (t *tree[K, V]) floor(key K) *node[K, V] {
+	var res *node[K, V]
+	for n := t.root; n != nil; {
+		c := t.cmp(n.key, key)
+		if c == 0 {
+			return n
+		} else if c < 0 {
+			res = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return res
+}
+
+// ceiling returns the node of the minimum key >= key, or nil.
+func (t *tree[K, V]) ceiling(key K) *node[K, V] {
+	var res *node[K, V]
+	for n := t.root; n != nil; {
+		c := t.cmp(n.key, key)
+		if c == 0 {
+			return n
+		} else if c > 0 {
+			res = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return res
+}
+
+// lower returns the node of the maximum key < key, or nil.
+func (t *tree[K, V]) lower(key K) *node[K, V] {
+	var res *node[K, V]
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) < 0 {
+			res = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return res
+}
+
+// higher returns the node of the minimum key > key, or nil.
+func (t *tree[K, V]) higher(key K) *node[K, V] {
+	var res *node[K, V]
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) > 0 {
+			res = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return res
+}
+
+// rank returns the number of keys < key.
+func (t *tree[K, V]) rank(key K) int {
+	r := 0
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) < 0 {
+			r += size(n.left) + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return r
+}
+
+// rankLE returns the number of keys <= key.
+func (t *tree[K, V]) rankLE(key K) int {
+	r := 0
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) <= 0 {
+			r += size(n.left) + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return r
+}
+
+// rankFunc returns the number of the leading keys with f(key), f must be true for the keys up to one and false after it.
+func (t *tree[K, V]) rankFunc(f func(key K) bool) int {
+	r := 0
+	for n := t.root; n != nil; {
+		if f(n.key) {
+			r += size(n.left) + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return r
+}
+
+// at returns the node of the i-th smallest key counting from 0, or nil if i is out of range.
+func (t *tree[K, V]) at(i int) *node[K, V] {
+	if i < 0 || i >= t.len() {
+		return nil
+	}
+	for n := t.root; n != nil; {
+		l := size(n.left)
+		if i < l {
+			n = n.left
+		} else if i > l {
+			i -= l + 1
+			n = n.right
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+// build returns a balanced tree of the n key-values returned by next, which must be in ASC without duplicates.
+// Every level is full except the deepest one, whose nodes are red and the others black,
+// so all paths have the same number of black nodes and no rotation is needed.
+// O(N)
+func (t *tree[K, V]) build(n int, next func() (K, V)) *node[K, V] {
+	deepest := bits.Len(uint(n)) - 1
+	var rec func(n, depth int) *node[K, V]
+	rec = func(n, depth int) *node[K, V] {
+		if n == 0 {
+			return nil
+		}
+		left := rec((n-1)/2, depth+1)
+		key, value := next()
+		right := rec(n-1-(n-1)/2, depth+1)
+		return &node[K, V]{left: left, right: right, key: key, value: value, size: n, gen: t.gen,
+			red: depth == deepest && depth > 0}
+	}
+	return rec(n, 0)
+}
+
+// put inserts the key-value, or replaces the value if the key exists.
+func (t *tree[K, V]) put(key K, value V) {
+	var p path[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			n = t.mutPath(&p, n)
+			n.value = value
+			return
+		}
+		p.push(n, c > 0)
+		if c < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	if t.cloneKey != nil {
+		key = t.cloneKey(key)
+	}
+	t.mutPath(&p, nil)
+	t.link(&p, p.n, &node[K, V]{key: key, value: value, size: 1, gen: t.gen, red: true})
+	for i := 0; i < p.n; i++ {
+		p.nodes[i].size++
+	}
+	t.fixPut(&p)
+}
+
+// fixPut restores the red-black properties after a red leaf was linked below p.nodes[p.n-1].
+// The nodes of p must be owned by t.
+func (t *tree[K, V]) fixPut(p *path[K, V]) {
+	// i is the depth of x, so p.nodes[i-1] is the parent and p.nodes[i-2] the grandparent.
+	i := p.n
+	for i >= 2 && p.nodes[i-1].red {
+		parent, grand := p.nodes[i-1], p.nodes[i-2]
+		var uncle *node[K, V]
+		if p.right[i-2] {
+			uncle = grand.left
+		} else {
+			uncle = grand.right
+		}
+		if isRed(uncle) {
+			uncle = t.mut(uncle)
+			if p.right[i-2] {
+				grand.left = uncle
+			} else {
+				grand.right = uncle
+			}
+			parent.red = false
+			uncle.red = false
+			grand.red = true
+			i -= 2
+			continue
+		}
+
+		var top *node[K, V]
+		if !p.right[i-2] {
+			if p.right[i-1] {
+				grand.left = rotateLeft(parent)
+			}
+			top = rotateRight(grand)
+		} else {
+			if !p.right[i-1] {
+				grand.right = rotateRight(parent)
+			}
+			top = rotateLeft(grand)
+		}
+		top.red = false
+		grand.red = true
+		t.link(p, i-2, top)
+		break
+	}
+	if t.root.red {
+		t.root = t.mut(t.root)
+		t.root.red = false
+	}
+}
+
+// delete removes the key and returns the removed key-value.
+func (t *tree[K, V]) delete(key K) (K, V, bool) {
+	var p path[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			break
+		}
+		p.push(n, c > 0)
+		if c < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	if n == nil {
+		var k K
+		var v V
+		return k, v, false
+	}
+	k, v := n.key, n.value
+	t.deleteAt(&p, n)
+	return k, v, true
+}
+
+// deleteAt removes n, whose ancestors are recorded in p.
+func (t *tree[K, V]) deleteAt(p *path[K, V], n *node[K, V]) {
+	n = t.mutPath(p, n)
+	if n.left != nil && n.right != nil {
+		// Move the successor into n, then remove the successor instead.
+		p.push(n, true)
+		s := t.mut(n.right)
+		n.right = s
+		for s.left != nil {
+			p.push(s, false)
+			s.left = t.mut(s.left)
+			s = s.left
+		}
+		n.key, n.value = s.key, s.value
+		n = s
+	}
+
+	child := n.left
+	if child == nil {
+		child = n.right
+	}
+	t.link(p, p.n, child)
+	for i := 0; i < p.n; i++ {
+		p.nodes[i].size--
+	}
+	if n.red {
+		return
+	}
+	if isRed(child) {
+		child = t.mut(child)
+		t.link(p, p.n, child)
+		child.red = false
+		return
+	}
+	t.fixDelete(p)
+}
+
+// fixDelete restores the red-black properties after a black node was removed below p.nodes[p.n-1].
+// The subtree that took its place, x, is short of one black node. The nodes of p must be owned by t.
+func (t *tree[K, V]) fixDelete(p *path[K, V]) {
+	i := p.n
+	var x *node[K, V]
+	if i > 0 {
+		if p.right[i-1] {
+			x = p.nodes[i-1].right
+		} else {
+			x = p.nodes[i-1].left
+		}
+	} else {
+		x = t.root
+	}
+
+	for i > 0 && !isRed(x) {
+		parent := p.nodes[i-1]
+		if !p.right[i-1] {
+			w := t.mut(parent.right)
+			parent.right = w
+			if w.red {
+				// Rotate so that x gets a black sibling; parent moves one level down.
+				w.red = false
+				parent.red = true
+				t.link(p, i-1, rotateLeft(parent))
+				p.nodes[i-1], p.right[i-1] = w, false
+				p.nodes[i], p.right[i] = parent, false
+				i++
+				w = t.mut(parent.right)
+				parent.right = w
+			}
+			if !isRed(w.left) && !isRed(w.right) {
+				w.red = true
+				x = parent
+				i--
+				continue
+			}
+			if !isRed(w.right) {
+				w.left = t.mut(w.left)
+				w.left.red = false
+				w.red = true
+				w = rotateRight(w)
+				parent.right = w
+			}
+			w.right = t.mut(w.right)
+			w.red = parent.red
+			parent.red = false
+			w.right.red = false
+			t.link(p, i-1, rotateLeft(parent))
+		} else {
+			w := t.mut(parent.left)
+			parent.left = w
+			if w.red {
+				w.red = false
+				parent.red = true
+				t.link(p, i-1, rotateRight(parent))
+				p.nodes[i-1], p.right[i-1] = w, true
+				p.nodes[i], p.right[i] = parent, true
+				i++
+				w = t.mut(parent.left)
+				parent.left = w
+			}
+			if !isRed(w.left) && !isRed(w.right) {
+				w.red = true
+				x = parent
+				i--
+				continue
+			}
+			if !isRed(w.left) {
+				w.right = t.mut(w.right)
+				w.right.red = false
+				w.red = true
+				w = rotateLeft(w)
+				parent.left = w
+			}
+			w.left = t.mut(w.left)
+			w.red = parent.red
+			parent.red = false
+			w.left.red = false
+			t.link(p, i-1, rotateRight(parent))
+		}
+		x = t.root
+		break
+	}
+	if isRed(x) {
+		// x is either the root or a node of p, both owned by t.
+		x.red = false
+	}
+}
+
+// iterator walks the tree in one direction. The stack holds the nodes not visited yet
+// whose subtree on the walking side has been visited, the top is the next node.
+type iterator[K, V any] struct {
+	stack [maxHeight]*node[K, V]
+	n     int
+}
+
+func (it *iterator[K, V]) pushLeft(n *node[K, V]) {
+	for ; n != nil; n = n.left {
+		it.stack[it.n] = n
+		it.n++
+	}
+}
+
+func (it *iterator[K, V]) pushRight(n *node[K, V]) {
+	for ; n != nil; n = n.right {
+		it.stack[it.n] = n
+		it.n++
+	}
+}
+
+// next returns the next node in ASC, the iterator must be placed by first, seekGE or seekGT.
+func (it *iterator[K, V]) next() *node[K, V] {
+	if it.n == 0 {
+		return nil
+	}
+	it.n--
+	n := it.stack[it.n]
+	it.pushLeft(n.right)
+	return n
+}
+
+// prev returns the next node in DESC, the iterator must be placed by last, seekLE or seekLT.
+func (it *iterator[K, V]) prev() *node[K, V] {
+	if it.n == 0 {
+		return nil
+	}
+	it.n--
+	n := it.stack[it.n]
+	it.pushRight(n.left)
+	return n
+}
+
+func (t *tree[K, V]) first(it *iterator[K, V]) {
+	it.n = 0
+	it.pushLeft(t.root)
+}
+
+func (t *tree[K, V]) last(it *iterator[K, V]) {
+	it.n = 0
+	it.pushRight(t.root)
+}
+
+// seekGE places it on the minimum key >= key for next.
+func (t *tree[K, V]) seekGE(it *iterator[K, V], key K) {
+	it.n = 0
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) >= 0 {
+			it.stack[it.n] = n
+			it.n++
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+}
+
+// seekFunc places it on the minimum key with f(key) >= 0 for next, f must be ascending in the order.
+func (t *tree[K, V]) seekFunc(it *iterator[K, V], f func(key K) int) {
+	it.n = 0
+	for n := t.root; n != nil; {
+		if f(n.key) >= 0 {
+			it.stack[it.n] = n
+			it.n++
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+}
+
+// seekGT places it on the minimum key > key for next.
+func (t *tree[K, V]) seekGT(it *iterator[K, V], key K) {
+	it.n = 0
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) > 0 {
+			it.stack[it.n] = n
+			it.n++
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+}
+
+// seekLE places it on the maximum key <= key for prev.
+func (t *tree[K, V]) seekLE(it *iterator[K, V], key K) {
+	it.n = 0
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) <= 0 {
+			it.stack[it.n] = n
+			it.n++
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+}
+
+// seekLT places it on the maximum key < key for prev.
+func (t *tree[K, V]) seekLT(it *iterator[K, V], key K) {
+	it.n = 0
+	for n := t.root; n != nil; {
+		if t.cmp(n.key, key) < 0 {
+			it.stack[it.n] = n
+			it.n++
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+}
+
+// String prints the tree level by level, each node is placed at the column of its in-order position.
+func (t *tree[K, V]) String() string {
+	type cell struct {
+		depth int
+		label string
+	}
+	var cells []cell
+	width := 0
+	var walk func(n *node[K, V], depth int)
+	walk = func(n *node[K, V], depth int) {
+		if n == nil {
+			return
+		}
+		walk(n.left, depth+1)
+		color := "B"
+		if n.red {
+			color = "R"
+		}
+		label := fmt.Sprintf("[%v]%s", n.key, color)
+		if len(label) > width {
+			width = len(label)
+		}
+		cells = append(cells, cell{depth: depth, label: label})
+		walk(n.right, depth+1)
+	}
+	walk(t.root, 0)
+
+	var lines []string
+	for i, c := range cells {
+		for len(lines) <= c.depth {
+			lines = append(lines, "")
+		}
+		lines[c.depth] += strings.Repeat(" ", i*width-len(lines[c.depth])) + c.label
+	}
+	var sb strings.Builder
+	for i := range lines {
+		sb.WriteString(lines[i])
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
@@ -0,0 +1,88 @@
+package orderedmap
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Pair2 is a composite key ordered lexicographically, by First and then by Second.
+// For example, all the entries of a tenant are contiguous in a map keyed by Pair2[uint64, int64]{tenantID, timestamp}.
+type Pair2[A, B cmp.Ordered] struct {
+	First  A
+	Second B
+}
+
+// Pair3 is a composite key ordered lexicographically, by First, Second and then by Third.
+type Pair3[A, B, C cmp.Ordered] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// MakePair2 returns the Pair2 of a and b.
+func MakePair2[A, B cmp.Ordered](a A, b B) Pair2[A, B] {
+	return Pair2[A, B]{First: a, Second: b}
+}
+
+// MakePair3 returns the Pair3 of a, b and c.
+func MakePair3[A, B, C cmp.Ordered](a A, b B, c C) Pair3[A, B, C] {
+	return Pair3[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// Compare returns -1, 0 or +1 if p is less than, equal to or greater than o.
+func (p Pair2[A, B]) Compare(o Pair2[A, B]) int {
+	if c := cmp.Compare(p.First, o.First); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.Second, o.Second)
+}
+
+// Compare returns -1, 0 or +1 if p is less than, equal to or greater than o.
+func (p Pair3[A, B, C]) Compare(o Pair3[A, B, C]) int {
+	if c := cmp.Compare(p.First, o.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(p.Second, o.Second); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.Third, o.Third)
+}
+
+// NewPair2 returns an empty map keyed by Pair2.
+// A range inside one First is a plain Range, for example:
+// m.Range(MakePair2(tenant, start), MakePair2(tenant, end))
+func NewPair2[A, B cmp.Ordered, V any]() *OrderedMap[Pair2[A, B], V] {
+	return NewFunc[Pair2[A, B], V](Pair2[A, B].Compare)
+}
+
+// NewPair3 returns an empty map keyed by Pair3.
+func NewPair3[A, B, C cmp.Ordered, V any]() *OrderedMap[Pair3[A, B, C], V] {
+	return NewFunc[Pair3[A, B, C], V](Pair3[A, B, C].Compare)
+}
+
+// Pair2First returns an iterator over the key-values whose keys have the First a in ASC.
+// O(logN) to start, O(1) amortized per key-value
+func Pair2First[A, B cmp.Ordered, V any](m *OrderedMap[Pair2[A, B], V], a A) iter.Seq2[Pair2[A, B], V] {
+	return m.BetweenFunc(func(key Pair2[A, B]) int {
+		return cmp.Compare(key.First, a)
+	})
+}
+
+// Pair3First returns an iterator over the key-values whose keys have the First a in ASC.
+// O(logN) to start, O(1) amortized per key-value
+func Pair3First[A, B, C cmp.Ordered, V any](m *OrderedMap[Pair3[A, B, C], V], a A) iter.Seq2[Pair3[A, B, C], V] {
+	return m.BetweenFunc(func(key Pair3[A, B, C]) int {
+		return cmp.Compare(key.First, a)
+	})
+}
+
+// Pair3FirstSecond returns an iterator over the key-values whose keys have the First a and the Second b in ASC.
+// O(logN) to start, O(1) amortized per key-value
+func Pair3FirstSecond[A, B, C cmp.Ordered, V any](m *OrderedMap[Pair3[A, B, C], V], a A, b B) iter.Seq2[Pair3[A, B, C], V] {
+	return m.BetweenFunc(func(key Pair3[A, B, C]) int {
+		if c := cmp.Compare(key.First, a); c != 0 {
+			return c
+		}
+		return cmp.Compare(key.Second, b)
+	})
+}
@@ -0,0 +1,459 @@
+package orderedmap
+
+import "cmp"
+
+// The typed maps are kept as aliases of OrderedMap, so the values are still interface{}.
+// Use New[K, V] directly to get typed values.
+type (
+	Byte    = OrderedMap[byte, interface{}]
+	Float32 = OrderedMap[float32, interface{}]
+	Float64 = OrderedMap[float64, interface{}]
+	Int     = OrderedMap[int, interface{}]
+	Int8    = OrderedMap[int8, interface{}]
+	Int16   = OrderedMap[int16, interface{}]
+	Int32   = OrderedMap[int32, interface{}]
+	Int64   = OrderedMap[int64, interface{}]
+	Rune    = OrderedMap[rune, interface{}]
+	String  = OrderedMap[string, interface{}]
+	Uint    = OrderedMap[uint, interface{}]
+	Uint8   = OrderedMap[uint8, interface{}]
+	Uint16  = OrderedMap[uint16, interface{}]
+	Uint32  = OrderedMap[uint32, interface{}]
+	Uint64  = OrderedMap[uint64, interface{}]
+	Uintptr = OrderedMap[uintptr, interface{}]
+)
+
+type (
+	ByteKeyValue    = KeyValue[byte, interface{}]
+	Float32KeyValue = KeyValue[float32, interface{}]
+	Float64KeyValue = KeyValue[float64, interface{}]
+	IntKeyValue     = KeyValue[int, interface{}]
+	Int8KeyValue    = KeyValue[int8, interface{}]
+	Int16KeyValue   = KeyValue[int16, interface{}]
+	Int32KeyValue   = KeyValue[int32, interface{}]
+	Int64KeyValue   = KeyValue[int64, interface{}]
+	RuneKeyValue    = KeyValue[rune, interface{}]
+	StringKeyValue  = KeyValue[string, interface{}]
+	UintKeyValue    = KeyValue[uint, interface{}]
+	Uint8KeyValue   = KeyValue[uint8, interface{}]
+	Uint16KeyValue  = KeyValue[uint16, interface{}]
+	Uint32KeyValue  = KeyValue[uint32, interface{}]
+	Uint64KeyValue  = KeyValue[uint64, interface{}]
+	UintptrKeyValue = KeyValue[uintptr, interface{}]
+)
+
+func NewByte() *Byte {
+	return New[byte, interface{}]()
+}
+
+// NewFloat32 returns a map ordered by cmp.Compare: NaN is less than any other number and equal to itself,
+// -0 and +0 are the same key. See NewFloat32Zeros to keep them distinct.
+func NewFloat32() *Float32 {
+	return New[float32, interface{}]()
+}
+
+// NewFloat64 returns a map ordered by cmp.Compare: NaN is less than any other number and equal to itself,
+// -0 and +0 are the same key. See NewFloat64Zeros to keep them distinct.
+func NewFloat64() *Float64 {
+	return New[float64, interface{}]()
+}
+
+func NewInt() *Int {
+	return New[int, interface{}]()
+}
+
+func NewInt8() *Int8 {
+	return New[int8, interface{}]()
+}
+
+func NewInt16() *Int16 {
+	return New[int16, interface{}]()
+}
+
+func NewInt32() *Int32 {
+	return New[int32, interface{}]()
+}
+
+func NewInt64() *Int64 {
+	return New[int64, interface{}]()
+}
+
+func NewRune() *Rune {
+	return New[rune, interface{}]()
+}
+
+func NewString() *String {
+	return New[string, interface{}]()
+}
+
+func NewUint() *Uint {
+	return New[uint, interface{}]()
+}
+
+func NewUint8() *Uint8 {
+	return New[uint8, interface{}]()
+}
+
+func NewUint16() *Uint16 {
+	return New[uint16, interface{}]()
+}
+
+func NewUint32() *Uint32 {
+	return New[uint32, interface{}]()
+}
+
+func NewUint64() *Uint64 {
+	return New[uint64, interface{}]()
+}
+
+func NewUintptr() *Uintptr {
+	return New[uintptr, interface{}]()
+}
+
+// The typed maps in DESC, Min and PopMin return the greatest key.
+
+func NewByteDesc() *Byte {
+	return NewFunc[byte, interface{}](Reverse(cmp.Compare[byte]))
+}
+
+func NewFloat32Desc() *Float32 {
+	return NewFunc[float32, interface{}](Reverse(cmp.Compare[float32]))
+}
+
+func NewFloat64Desc() *Float64 {
+	return NewFunc[float64, interface{}](Reverse(cmp.Compare[float64]))
+}
+
+func NewIntDesc() *Int {
+	return NewFunc[int, interface{}](Reverse(cmp.Compare[int]))
+}
+
+func NewInt8Desc() *Int8 {
+	return NewFunc[int8, interface{}](Reverse(cmp.Compare[int8]))
+}
+
+func NewInt16Desc() *Int16 {
+	return NewFunc[int16, interface{}](Reverse(cmp.Compare[int16]))
+}
+
+func NewInt32Desc() *Int32 {
+	return NewFunc[int32, interface{}](Reverse(cmp.Compare[int32]))
+}
+
+func NewInt64Desc() *Int64 {
+	return NewFunc[int64, interface{}](Reverse(cmp.Compare[int64]))
+}
+
+func NewRuneDesc() *Rune {
+	return NewFunc[rune, interface{}](Reverse(cmp.Compare[rune]))
+}
+
+func NewStringDesc() *String {
+	return NewFunc[string, interface{}](Reverse(cmp.Compare[string]))
+}
+
+func NewUintDesc() *Uint {
+	return NewFunc[uint, interface{}](Reverse(cmp.Compare[uint]))
+}
+
+func NewUint8Desc() *Uint8 {
+	return NewFunc[uint8, interface{}](Reverse(cmp.Compare[uint8]))
+}
+
+func NewUint16Desc() *Uint16 {
+	return NewFunc[uint16, interface{}](Reverse(cmp.Compare[uint16]))
+}
+
+func NewUint32Desc() *Uint32 {
+	return NewFunc[uint32, interface{}](Reverse(cmp.Compare[uint32]))
+}
+
+func NewUint64Desc() *Uint64 {
+	return NewFunc[uint64, interface{}](Reverse(cmp.Compare[uint64]))
+}
+
+func NewUintptrDesc() *Uintptr {
+	return NewFunc[uintptr, interface{}](Reverse(cmp.Compare[uintptr]))
+}
+
+// The typed maps ordered by cmp, which must be a total order, see NewFunc.
+
+func NewByteFunc(cmp func(a, b byte) int) *Byte {
+	return NewFunc[byte, interface{}](cmp)
+}
+
+func NewFloat32Func(cmp func(a, b float32) int) *Float32 {
+	return NewFunc[float32, interface{}](cmp)
+}
+
+func NewFloat64Func(cmp func(a, b float64) int) *Float64 {
+	return NewFunc[float64, interface{}](cmp)
+}
+
+func NewIntFunc(cmp func(a, b int) int) *Int {
+	return NewFunc[int, interface{}](cmp)
+}
+
+func NewInt8Func(cmp func(a, b int8) int) *Int8 {
+	return NewFunc[int8, interface{}](cmp)
+}
+
+func NewInt16Func(cmp func(a, b int16) int) *Int16 {
+	return NewFunc[int16, interface{}](cmp)
+}
+
+func NewInt32Func(cmp func(a, b int32) int) *Int32 {
+	return NewFunc[int32, interface{}](cmp)
+}
+
+func NewInt64Func(cmp func(a, b int64) int) *Int64 {
+	return NewFunc[int64, interface{}](cmp)
+}
+
+func NewRuneFunc(cmp func(a, b rune) int) *Rune {
+	return NewFunc[rune, interface{}](cmp)
+}
+
+func NewStringFunc(cmp func(a, b string) int) *String {
+	return NewFunc[string, interface{}](cmp)
+}
+
+func NewUintFunc(cmp func(a, b uint) int) *Uint {
+	return NewFunc[uint, interface{}](cmp)
+}
+
+func NewUint8Func(cmp func(a, b uint8) int) *Uint8 {
+	return NewFunc[uint8, interface{}](cmp)
+}
+
+func NewUint16Func(cmp func(a, b uint16) int) *Uint16 {
+	return NewFunc[uint16, interface{}](cmp)
+}
+
+func NewUint32Func(cmp func(a, b uint32) int) *Uint32 {
+	return NewFunc[uint32, interface{}](cmp)
+}
+
+func NewUint64Func(cmp func(a, b uint64) int) *Uint64 {
+	return NewFunc[uint64, interface{}](cmp)
+}
+
+func NewUintptrFunc(cmp func(a, b uintptr) int) *Uintptr {
+	return NewFunc[uintptr, interface{}](cmp)
+}
+
+// The typed concurrent maps, see SyncOrderedMap.
+type (
+	SyncByte    = SyncOrderedMap[byte, interface{}]
+	SyncFloat32 = SyncOrderedMap[float32, interface{}]
+	SyncFloat64 = SyncOrderedMap[float64, interface{}]
+	SyncInt     = SyncOrderedMap[int, interface{}]
+	SyncInt8    = SyncOrderedMap[int8, interface{}]
+	SyncInt16   = SyncOrderedMap[int16, interface{}]
+	SyncInt32   = SyncOrderedMap[int32, interface{}]
+	SyncInt64   = SyncOrderedMap[int64, interface{}]
+	SyncRune    = SyncOrderedMap[rune, interface{}]
+	SyncString  = SyncOrderedMap[string, interface{}]
+	SyncUint    = SyncOrderedMap[uint, interface{}]
+	SyncUint8   = SyncOrderedMap[uint8, interface{}]
+	SyncUint16  = SyncOrderedMap[uint16, interface{}]
+	SyncUint32  = SyncOrderedMap[uint32, interface{}]
+	SyncUint64  = SyncOrderedMap[uint64, interface{}]
+	SyncUintptr = SyncOrderedMap[uintptr, interface{}]
+)
+
+func NewSyncByte() *SyncByte {
+	return NewSync[byte, interface{}]()
+}
+
+func NewSyncFloat32() *SyncFloat32 {
+	return NewSync[float32, interface{}]()
+}
+
+func NewSyncFloat64() *SyncFloat64 {
+	return NewSync[float64, interface{}]()
+}
+
+func NewSyncInt() *SyncInt {
+	return NewSync[int, interface{}]()
+}
+
+func NewSyncInt8() *SyncInt8 {
+	return NewSync[int8, interface{}]()
+}
+
+func NewSyncInt16() *SyncInt16 {
+	return NewSync[int16, interface{}]()
+}
+
+func NewSyncInt32() *SyncInt32 {
+	return NewSync[int32, interface{}]()
+}
+
+func NewSyncInt64() *SyncInt64 {
+	return NewSync[int64, interface{}]()
+}
+
+func NewSyncRune() *SyncRune {
+	return NewSync[rune, interface{}]()
+}
+
+func NewSyncString() *SyncString {
+	return NewSync[string, interface{}]()
+}
+
+func NewSyncUint() *SyncUint {
+	return NewSync[uint, interface{}]()
+}
+
+func NewSyncUint8() *SyncUint8 {
+	return NewSync[uint8, interface{}]()
+}
+
+func NewSyncUint16() *SyncUint16 {
+	return NewSync[uint16, interface{}]()
+}
+
+func NewSyncUint32() *SyncUint32 {
+	return NewSync[uint32, interface{}]()
+}
+
+func NewSyncUint64() *SyncUint64 {
+	return NewSync[uint64, interface{}]()
+}
+
+func NewSyncUintptr() *SyncUintptr {
+	return NewSync[uintptr, interface{}]()
+}
+
+// The typed concurrent maps in DESC.
+
+func NewSyncByteDesc() *SyncByte {
+	return NewSyncFunc[byte, interface{}](Reverse(cmp.Compare[byte]))
+}
+
+func NewSyncFloat32Desc() *SyncFloat32 {
+	return NewSyncFunc[float32, interface{}](Reverse(cmp.Compare[float32]))
+}
+
+func NewSyncFloat64Desc() *SyncFloat64 {
+	return NewSyncFunc[float64, interface{}](Reverse(cmp.Compare[float64]))
+}
+
+func NewSyncIntDesc() *SyncInt {
+	return NewSyncFunc[int, interface{}](Reverse(cmp.Compare[int]))
+}
+
+func NewSyncInt8Desc() *SyncInt8 {
+	return NewSyncFunc[int8, interface{}](Reverse(cmp.Compare[int8]))
+}
+
+func NewSyncInt16Desc() *SyncInt16 {
+	return NewSyncFunc[int16, interface{}](Reverse(cmp.Compare[int16]))
+}
+
+func NewSyncInt32Desc() *SyncInt32 {
+	return NewSyncFunc[int32, interface{}](Reverse(cmp.Compare[int32]))
+}
+
+func NewSyncInt64Desc() *SyncInt64 {
+	return NewSyncFunc[int64, interface{}](Reverse(cmp.Compare[int64]))
+}
+
+func NewSyncRuneDesc() *SyncRune {
+	return NewSyncFunc[rune, interface{}](Reverse(cmp.Compare[rune]))
+}
+
+func NewSyncStringDesc() *SyncString {
+	return NewSyncFunc[string, interface{}](Reverse(cmp.Compare[string]))
+}
+
+func NewSyncUintDesc() *SyncUint {
+	return NewSyncFunc[uint, interface{}](Reverse(cmp.Compare[uint]))
+}
+
+func NewSyncUint8Desc() *SyncUint8 {
+	return NewSyncFunc[uint8, interface{}](Reverse(cmp.Compare[uint8]))
+}
+
+func NewSyncUint16Desc() *SyncUint16 {
+	return NewSyncFunc[uint16, interface{}](Reverse(cmp.Compare[uint16]))
+}
+
+func NewSyncUint32Desc() *SyncUint32 {
+	return NewSyncFunc[uint32, interface{}](Reverse(cmp.Compare[uint32]))
+}
+
+func NewSyncUint64Desc() *SyncUint64 {
+	return NewSyncFunc[uint64, interface{}](Reverse(cmp.Compare[uint64]))
+}
+
+func NewSyncUintptrDesc() *SyncUintptr {
+	return NewSyncFunc[uintptr, interface{}](Reverse(cmp.Compare[uintptr]))
+}
+
+// The typed concurrent maps ordered by cmp.
+
+func NewSyncByteFunc(cmp func(a, b byte) int) *SyncByte {
+	return NewSyncFunc[byte, interface{}](cmp)
+}
+
+func NewSyncFloat32Func(cmp func(a, b float32) int) *SyncFloat32 {
+	return NewSyncFunc[float32, interface{}](cmp)
+}
+
+func NewSyncFloat64Func(cmp func(a, b float64) int) *SyncFloat64 {
+	return NewSyncFunc[float64, interface{}](cmp)
+}
+
+func NewSyncIntFunc(cmp func(a, b int) int) *SyncInt {
+	return NewSyncFunc[int, interface{}](cmp)
+}
+
+func NewSyncInt8Func(cmp func(a, b int8) int) *SyncInt8 {
+	return NewSyncFunc[int8, interface{}](cmp)
+}
+
+func NewSyncInt16Func(cmp func(a, b int16) int) *SyncInt16 {
+	return NewSyncFunc[int16, interface{}](cmp)
+}
+
+func NewSyncInt32Func(cmp func(a, b int32) int) *SyncInt32 {
+	return NewSyncFunc[int32, interface{}](cmp)
+}
+
+func NewSyncInt64Func(cmp func(a, b int64) int) *SyncInt64 {
+	return NewSyncFunc[int64, interface{}](cmp)
+}
+
+func NewSyncRuneFunc(cmp func(a, b rune) int) *SyncRune {
+	return NewSyncFunc[rune, interface{}](cmp)
+}
+
+func NewSyncStringFunc(cmp func(a, b string) int) *SyncString {
+	return NewSyncFunc[string, interface{}](cmp)
+}
+
+func NewSyncUintFunc(cmp func(a, b uint) int) *SyncUint {
+	return NewSyncFunc[uint, interface{}](cmp)
+}
+
+func NewSyncUint8Func(cmp func(a, b uint8) int) *SyncUint8 {
+	return NewSyncFunc[uint8, interface{}](cmp)
+}
+
+func NewSyncUint16Func(cmp func(a, b uint16) int) *SyncUint16 {
+	return NewSyncFunc[uint16, interface{}](cmp)
+}
+
+func NewSyncUint32Func(cmp func(a, b uint32) int) *SyncUint32 {
+	return NewSyncFunc[uint32, interface{}](cmp)
+}
+
+func NewSyncUint64Func(cmp func(a, b uint64) int) *SyncUint64 {
+	return NewSyncFunc[uint64, interface{}](cmp)
+}
+
+func NewSyncUintptrFunc(cmp func(a, b uintptr) int) *SyncUintptr {
+	return NewSyncFunc[uintptr, interface{}](cmp)
+}